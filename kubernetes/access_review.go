@@ -0,0 +1,262 @@
+package kubernetes
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	authv1 "k8s.io/api/authorization/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// accessReviewTarget describes the API resource managed by a Terraform
+// resource type, as far as needed to review access to it.
+type accessReviewTarget struct {
+	group      string
+	resource   string
+	namespaced bool
+	// updateVerb is the verb used by the resource's Update function
+	updateVerb string
+}
+
+var accessReviewTargets = map[string]accessReviewTarget{
+	"kubernetes_api_service":                 {"apiregistration.k8s.io", "apiservices", false, "patch"},
+	"kubernetes_certificate_signing_request": {"certificates.k8s.io", "certificatesigningrequests", false, "patch"},
+	"kubernetes_cluster_role":                {"rbac.authorization.k8s.io", "clusterroles", false, "patch"},
+	"kubernetes_cluster_role_binding":        {"rbac.authorization.k8s.io", "clusterrolebindings", false, "patch"},
+	"kubernetes_config_map":                  {"", "configmaps", true, "patch"},
+	"kubernetes_custom_resource_definition":  {"apiextensions.k8s.io", "customresourcedefinitions", false, "patch"},
+	"kubernetes_endpoints":                   {"", "endpoints", true, "patch"},
+	"kubernetes_horizontal_pod_autoscaler":   {"autoscaling", "horizontalpodautoscalers", true, "patch"},
+	"kubernetes_job":                         {"batch", "jobs", true, "patch"},
+	"kubernetes_cron_job":                    {"batch", "cronjobs", true, "update"},
+	"kubernetes_ingress":                     {"extensions", "ingresses", true, "update"},
+	"kubernetes_limit_range":                 {"", "limitranges", true, "patch"},
+	"kubernetes_namespace":                   {"", "namespaces", false, "patch"},
+	"kubernetes_persistent_volume":           {"", "persistentvolumes", false, "patch"},
+	"kubernetes_persistent_volume_claim":     {"", "persistentvolumeclaims", true, "patch"},
+	"kubernetes_pod":                         {"", "pods", true, "patch"},
+	"kubernetes_pod_preset":                  {"settings.k8s.io", "podpresets", true, "patch"},
+	"kubernetes_pod_security_policy":         {"policy", "podsecuritypolicies", false, "patch"},
+	"kubernetes_replication_controller":      {"", "replicationcontrollers", true, "patch"},
+	"kubernetes_deployment":                  {"apps", "deployments", true, "patch"},
+	"kubernetes_daemonset":                   {"apps", "daemonsets", true, "update"},
+	"kubernetes_resource_quota":              {"", "resourcequotas", true, "patch"},
+	"kubernetes_secret":                      {"", "secrets", true, "patch"},
+	"kubernetes_service":                     {"", "services", true, "update"},
+	"kubernetes_service_account":             {"", "serviceaccounts", true, "patch"},
+	"kubernetes_stateful_set":                {"apps", "statefulsets", true, "patch"},
+	"kubernetes_storage_class":               {"storage.k8s.io", "storageclasses", false, "patch"},
+}
+
+// accessReviewAPIGroups lists the API groups of the resource types which
+// use the highest one supported by the server, so that the review targets
+// the same group.
+var accessReviewAPIGroups = map[string][]APIGroup{
+	"kubernetes_cron_job":            cronJobAPIGroups,
+	"kubernetes_daemonset":           daemonSetAPIGroups,
+	"kubernetes_deployment":          deploymentsAPIGroups,
+	"kubernetes_pod_security_policy": podSecurityPoliciesAPIGroups,
+	"kubernetes_stateful_set":        statefulSetAPIGroups,
+}
+
+// customizeDiffAccessReview wraps the CustomizeDiff function of a resource
+// type with a review of the permissions needed to apply its diff, which is
+// only carried out if check_permissions is enabled. Missing permissions are
+// collected across all resources and reported at once by
+// failOnMissingPermissions.
+func customizeDiffAccessReview(rtype string, s map[string]*schema.Schema, next schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(diff *schema.ResourceDiff, meta interface{}) error {
+		if next != nil {
+			err := next(diff, meta)
+			if err != nil {
+				return err
+			}
+		}
+		if kp, ok := meta.(*kubernetesProvider); ok && kp.checkPermissions {
+			return checkResourcePermissions(rtype, s, diff, kp)
+		}
+		return nil
+	}
+}
+
+// failOnMissingPermissions wraps the Create, Update and Delete functions of a
+// resource type so that the apply fails before changing anything if the
+// review found permissions missing for any of the planned changes.
+func failOnMissingPermissions(next func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		if kp, ok := meta.(*kubernetesProvider); ok && kp.checkPermissions {
+			err := kp.missingPermissionsError()
+			if err != nil {
+				return err
+			}
+		}
+		return next(d, meta)
+	}
+}
+
+// checkResourcePermissions reviews the verbs needed to create, update or
+// replace the resource and records the ones denied.
+func checkResourcePermissions(rtype string, s map[string]*schema.Schema, diff *schema.ResourceDiff, kp *kubernetesProvider) error {
+	target, ok := accessReviewTargets[rtype]
+	if !ok {
+		log.Printf("[WARN] No access review target known for %s, skipping permission check", rtype)
+		return nil
+	}
+
+	attrs := authv1.ResourceAttributes{
+		Group:    target.group,
+		Resource: target.resource,
+	}
+	if groups, ok := accessReviewAPIGroups[rtype]; ok {
+		group, err := kp.highestSupportedAPIGroup(target.resource, groups...)
+		if err != nil {
+			return fmt.Errorf("Failed to review access for %s: %s", rtype, err)
+		}
+		if group != none {
+			attrs.Group = strings.Split(group.String(), "/")[0]
+		}
+	}
+	if target.namespaced {
+		namespace, known := plannedNamespace(diff, kp.metadataSettings.DefaultNamespace)
+		if !known {
+			log.Printf("[DEBUG] Namespace of %s not known yet, skipping permission check", rtype)
+			return nil
+		}
+		attrs.Namespace = namespace
+	}
+
+	var verbs []string
+	if diff.Id() == "" {
+		verbs = []string{"create", "get"}
+	} else {
+		if len(diff.GetChangedKeysPrefix("")) == 0 {
+			return nil
+		}
+		// The object is created again by a second diff without state
+		verbs = []string{target.updateVerb}
+		if isReplacement(s, diff) {
+			verbs = []string{"delete"}
+		}
+		// The name of the object in place
+		name, _ := diff.GetChange("metadata.0.name")
+		attrs.Name = name.(string)
+	}
+
+	for _, verb := range verbs {
+		attrs.Verb = verb
+		status, err := kp.reviewAccess(attrs)
+		if err != nil {
+			return fmt.Errorf("Failed to review access for %s: %s", rtype, err)
+		}
+		if !status.Allowed {
+			kp.addMissingPermission(rtype, describeAccessReview(attrs, status))
+		}
+	}
+	return nil
+}
+
+// isReplacement returns whether the diff replaces the object, either through
+// a ForceNew attribute or a CustomizeDiff function forcing a new resource.
+func isReplacement(s map[string]*schema.Schema, diff *schema.ResourceDiff) bool {
+	for _, key := range diff.GetChangedKeysPrefix("") {
+		if isForceNewKey(s, key) {
+			return true
+		}
+	}
+	// Only computed attributes may be set otherwise
+	for _, key := range diff.UpdatedKeys() {
+		if v, ok := s[key]; ok && !v.Computed {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *kubernetesProvider) addMissingPermission(rtype, permission string) {
+	p.accessReviewsMu.Lock()
+	defer p.accessReviewsMu.Unlock()
+
+	log.Printf("[WARN] Missing permission for %s: %s", rtype, permission)
+	entry := fmt.Sprintf("%s (%s)", permission, rtype)
+	if !isValueInStringList(entry, p.missingPermissions) {
+		p.missingPermissions = append(p.missingPermissions, entry)
+	}
+}
+
+// missingPermissionsError returns a single error listing the permissions
+// missing for all the changes reviewed. Later calls only refer to it, as
+// Terraform reports the errors of all the resources together.
+func (p *kubernetesProvider) missingPermissionsError() error {
+	p.accessReviewsMu.Lock()
+	defer p.accessReviewsMu.Unlock()
+
+	if len(p.missingPermissions) == 0 {
+		return nil
+	}
+	if p.missingPermissionsReported {
+		return fmt.Errorf("Not applied, as permissions needed by the planned changes are missing")
+	}
+	p.missingPermissionsReported = true
+	missing := append([]string{}, p.missingPermissions...)
+	sort.Strings(missing)
+	return fmt.Errorf("Missing permissions for the planned changes:\n\n  - %s", strings.Join(missing, "\n  - "))
+}
+
+// reviewAccess runs a SelfSubjectAccessReview, caching its result for the
+// lifetime of the provider, as many resources share the same attributes.
+func (p *kubernetesProvider) reviewAccess(attrs authv1.ResourceAttributes) (*authv1.SubjectAccessReviewStatus, error) {
+	p.accessReviewsMu.Lock()
+	defer p.accessReviewsMu.Unlock()
+
+	if status, ok := p.accessReviews[attrs]; ok {
+		return status, nil
+	}
+	status, err := selfSubjectAccessReview(p.conn, attrs)
+	if err != nil {
+		return nil, err
+	}
+	if p.accessReviews == nil {
+		p.accessReviews = make(map[authv1.ResourceAttributes]*authv1.SubjectAccessReviewStatus)
+	}
+	p.accessReviews[attrs] = status
+	return status, nil
+}
+
+func selfSubjectAccessReview(conn *kubernetes.Clientset, attrs authv1.ResourceAttributes) (*authv1.SubjectAccessReviewStatus, error) {
+	review := authv1.SelfSubjectAccessReview{
+		Spec: authv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &attrs,
+		},
+	}
+	log.Printf("[DEBUG] Reviewing access: %#v", attrs)
+	out, err := conn.AuthorizationV1().SelfSubjectAccessReviews().Create(&review)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] Received access review status: %#v", out.Status)
+	return &out.Status, nil
+}
+
+func describeAccessReview(attrs authv1.ResourceAttributes, status *authv1.SubjectAccessReviewStatus) string {
+	resource := attrs.Resource
+	if attrs.Group != "" {
+		resource = resource + "." + attrs.Group
+	}
+	if attrs.Subresource != "" {
+		resource = resource + "/" + attrs.Subresource
+	}
+	s := fmt.Sprintf("%s %s", attrs.Verb, resource)
+	if attrs.Name != "" {
+		s += fmt.Sprintf(" %q", attrs.Name)
+	}
+	if attrs.Namespace != "" {
+		s += fmt.Sprintf(" in namespace %q", attrs.Namespace)
+	}
+	if status.Reason != "" {
+		s += fmt.Sprintf(" (%s)", status.Reason)
+	}
+	return s
+}
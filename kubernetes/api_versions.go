@@ -22,6 +22,8 @@ const (
 	batchV1beta1
 	batchV2alpha1
 	extensionsV1beta1
+	policyV1beta1
+	settingsV1alpha1
 )
 
 func (g APIGroup) String() string {
@@ -38,6 +40,10 @@ func (g APIGroup) String() string {
 		return "batch/v1beta1"
 	case batchV2alpha1:
 		return "batch/v2alpha1"
+	case policyV1beta1:
+		return "policy/v1beta1"
+	case settingsV1alpha1:
+		return "settings.k8s.io/v1alpha1"
 	default:
 		return "none"
 	}
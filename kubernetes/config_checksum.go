@@ -0,0 +1,321 @@
+package kubernetes
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+)
+
+// configChecksumAnnotation is stamped on the pod template of workloads with
+// rollout_on_config_change enabled. Any change of its value makes the
+// controller roll out new pods.
+const configChecksumAnnotation = "terraform.io/config-checksum"
+
+func rolloutOnConfigChangeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Roll out new pods whenever the content of a config map or secret referenced by the pod template (volumes, `env.value_from`, `env_from`) changes.",
+		Optional:    true,
+		Default:     false,
+	}
+}
+
+func configChecksumSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Checksum of the config maps and secrets referenced by the pod template, stamped on the pod template when `rollout_on_config_change` is enabled.",
+		Computed:    true,
+	}
+}
+
+// customizeDiffConfigChecksum plans a new config_checksum whenever the content
+// of the config maps and secrets referenced by the pod template changes.
+func customizeDiffConfigChecksum(diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.Get("rollout_on_config_change").(bool) {
+		if diff.Get("config_checksum").(string) != "" {
+			return diff.SetNew("config_checksum", "")
+		}
+		return nil
+	}
+
+	spec, err := expandPodSpec(diff.Get("spec.0.template.0.spec").([]interface{}))
+	if err != nil {
+		return err
+	}
+	// Names interpolated from objects not created yet are unknown (empty)
+	// until the apply, which computes the checksum again
+	kp := meta.(*kubernetesProvider)
+	namespace, known := plannedNamespace(diff, kp.metadataSettings.DefaultNamespace)
+	configMaps, secrets := podSpecConfigReferences(spec)
+	if !known || isValueInStringList("", configMaps) || isValueInStringList("", secrets) {
+		log.Printf("[DEBUG] Config checksum of pod template depends on unknown references")
+		return diff.SetNewComputed("config_checksum")
+	}
+	// The apply stamps the checksum of the config as it is by then, which
+	// may be changed earlier in the same apply, so it isn't known up front
+	if diff.Id() == "" || len(diff.GetChangedKeysPrefix("")) > 0 {
+		return diff.SetNewComputed("config_checksum")
+	}
+
+	checksum, err := podSpecConfigChecksum(kp.conn, namespace, spec)
+	if err != nil {
+		return err
+	}
+
+	if checksum != diff.Get("config_checksum").(string) {
+		log.Printf("[DEBUG] Config checksum of pod template changed to %s", checksum)
+		return diff.SetNew("config_checksum", checksum)
+	}
+	return nil
+}
+
+// setConfigChecksumAnnotation stamps the checksum of the referenced config
+// maps and secrets on the pod template. The planned checksum is stale when
+// one of them is updated earlier in the same apply, so it's computed again.
+func setConfigChecksumAnnotation(d *schema.ResourceData, conn *kubernetes.Clientset, namespace string, template *v1.PodTemplateSpec) error {
+	if !d.Get("rollout_on_config_change").(bool) {
+		return nil
+	}
+	checksum, err := podSpecConfigChecksum(conn, namespace, template.Spec)
+	if err != nil {
+		return err
+	}
+	if template.ObjectMeta.Annotations == nil {
+		template.ObjectMeta.Annotations = make(map[string]string)
+	}
+	template.ObjectMeta.Annotations[configChecksumAnnotation] = checksum
+	return nil
+}
+
+// podSpecConfigReferences returns the sorted names of the config maps and
+// secrets the pod spec refers to.
+func podSpecConfigReferences(spec v1.PodSpec) (configMaps []string, secrets []string) {
+	cms := make(map[string]struct{})
+	scs := make(map[string]struct{})
+
+	for _, vol := range spec.Volumes {
+		if vol.ConfigMap != nil {
+			cms[vol.ConfigMap.Name] = struct{}{}
+		}
+		if vol.Secret != nil {
+			scs[vol.Secret.SecretName] = struct{}{}
+		}
+		if vol.Projected != nil {
+			for _, src := range vol.Projected.Sources {
+				if src.ConfigMap != nil {
+					cms[src.ConfigMap.Name] = struct{}{}
+				}
+				if src.Secret != nil {
+					scs[src.Secret.Name] = struct{}{}
+				}
+			}
+		}
+	}
+
+	containers := append([]v1.Container{}, spec.InitContainers...)
+	containers = append(containers, spec.Containers...)
+	for _, c := range containers {
+		for _, env := range c.Env {
+			if env.ValueFrom == nil {
+				continue
+			}
+			if env.ValueFrom.ConfigMapKeyRef != nil {
+				cms[env.ValueFrom.ConfigMapKeyRef.Name] = struct{}{}
+			}
+			if env.ValueFrom.SecretKeyRef != nil {
+				scs[env.ValueFrom.SecretKeyRef.Name] = struct{}{}
+			}
+		}
+		for _, envFrom := range c.EnvFrom {
+			if envFrom.ConfigMapRef != nil {
+				cms[envFrom.ConfigMapRef.Name] = struct{}{}
+			}
+			if envFrom.SecretRef != nil {
+				scs[envFrom.SecretRef.Name] = struct{}{}
+			}
+		}
+	}
+
+	return sortedKeys(cms), sortedKeys(scs)
+}
+
+// podSpecConfigChecksum hashes the content of every config map and secret
+// referenced by the pod spec. Objects which don't exist (yet) are hashed
+// as absent, unknown references are left out.
+func podSpecConfigChecksum(conn *kubernetes.Clientset, namespace string, spec v1.PodSpec) (string, error) {
+	configMaps, secrets := podSpecConfigReferences(spec)
+	h := sha256.New()
+
+	for _, name := range configMaps {
+		if name == "" {
+			continue
+		}
+		fmt.Fprintf(h, "configmap/%s\n", name)
+		cfgMap, err := conn.CoreV1().ConfigMaps(namespace).Get(name, metav1.GetOptions{})
+		if err != nil {
+			if errors.IsNotFound(err) {
+				io.WriteString(h, "absent\n")
+				continue
+			}
+			return "", fmt.Errorf("Failed to read config map %q: %s", name, err)
+		}
+		binaryData, err := getConfigMapBinaryData(conn, namespace, name)
+		if err != nil {
+			return "", err
+		}
+		data := make(map[string][]byte)
+		for k, v := range cfgMap.Data {
+			data[k] = []byte(v)
+		}
+		writeByteMapChecksum(h, data)
+		writeByteMapChecksum(h, binaryData)
+	}
+
+	for _, name := range secrets {
+		if name == "" {
+			continue
+		}
+		fmt.Fprintf(h, "secret/%s\n", name)
+		secret, err := conn.CoreV1().Secrets(namespace).Get(name, metav1.GetOptions{})
+		if err != nil {
+			if errors.IsNotFound(err) {
+				io.WriteString(h, "absent\n")
+				continue
+			}
+			return "", fmt.Errorf("Failed to read secret %q: %s", name, err)
+		}
+		writeByteMapChecksum(h, secret.Data)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// configChecksumWorkloads are the kinds of workloads which may roll out on
+// config changes.
+var configChecksumWorkloads = []struct {
+	resource string
+	groups   []APIGroup
+}{
+	{deploymentsResourceGroupName, deploymentsAPIGroups},
+	{daemonSetResourceGroupName, daemonSetAPIGroups},
+	{statefulSetResourceGroupName, statefulSetAPIGroups},
+}
+
+// configChecksumWorkloadList holds the part of a list of workloads of any
+// kind needed to refresh their config checksum.
+type configChecksumWorkloadList struct {
+	Items []struct {
+		metav1.ObjectMeta `json:"metadata"`
+		Spec              struct {
+			Template v1.PodTemplateSpec `json:"template"`
+		} `json:"spec"`
+	} `json:"items"`
+}
+
+// rolloutConfigConsumers refreshes the config checksum of the workloads
+// rolling out on config changes which refer to the given config map or
+// secret. The plan of a workload can't tell that an object it refers to
+// changes in the same apply, so the rollout is started here and picked up
+// by the next refresh of the workload.
+func rolloutConfigConsumers(kp *kubernetesProvider, namespace string, configMap, secret string) error {
+	for _, w := range configChecksumWorkloads {
+		group, err := kp.highestSupportedAPIGroup(w.resource, w.groups...)
+		if err != nil {
+			return err
+		}
+		if group == none {
+			continue
+		}
+
+		raw, err := kp.conn.CoreV1().RESTClient().Get().
+			AbsPath("/apis", group.String(), "namespaces", namespace, w.resource).
+			DoRaw()
+		if err != nil {
+			return err
+		}
+		var list configChecksumWorkloadList
+		err = json.Unmarshal(raw, &list)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range list.Items {
+			current, ok := item.Spec.Template.Annotations[configChecksumAnnotation]
+			if !ok {
+				continue
+			}
+			configMaps, secrets := podSpecConfigReferences(item.Spec.Template.Spec)
+			if !isValueInStringList(configMap, configMaps) && !isValueInStringList(secret, secrets) {
+				continue
+			}
+			checksum, err := podSpecConfigChecksum(kp.conn, namespace, item.Spec.Template.Spec)
+			if err != nil {
+				return err
+			}
+			if checksum == current {
+				continue
+			}
+
+			ops := PatchOperations{
+				&ReplaceOperation{
+					Path:  "/spec/template/metadata/annotations/" + escapeJsonPointer(configChecksumAnnotation),
+					Value: checksum,
+				},
+			}
+			data, err := ops.MarshalJSON()
+			if err != nil {
+				return err
+			}
+			log.Printf("[INFO] Rolling out %s %q for changed config: %s", w.resource, item.Name, string(data))
+			_, err = kp.conn.CoreV1().RESTClient().Patch(types.JSONPatchType).
+				AbsPath("/apis", group.String(), "namespaces", namespace, w.resource, item.Name).
+				Body(data).
+				DoRaw()
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func isValueInStringList(v string, l []string) bool {
+	for _, s := range l {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
+
+func writeByteMapChecksum(h hash.Hash, m map[string][]byte) {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Fprintf(h, "%s=%x\n", k, m[k])
+	}
+}
+
+func sortedKeys(m map[string]struct{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
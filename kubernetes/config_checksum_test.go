@@ -0,0 +1,72 @@
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/api/core/v1"
+)
+
+func TestPodSpecConfigReferences(t *testing.T) {
+	cases := []struct {
+		Input              v1.PodSpec
+		ExpectedConfigMaps []string
+		ExpectedSecrets    []string
+	}{
+		{
+			v1.PodSpec{},
+			[]string{},
+			[]string{},
+		},
+		{
+			v1.PodSpec{
+				Volumes: []v1.Volume{
+					{Name: "config", VolumeSource: v1.VolumeSource{
+						ConfigMap: &v1.ConfigMapVolumeSource{LocalObjectReference: v1.LocalObjectReference{Name: "vol-cm"}},
+					}},
+					{Name: "tls", VolumeSource: v1.VolumeSource{
+						Secret: &v1.SecretVolumeSource{SecretName: "vol-secret"},
+					}},
+					{Name: "scratch", VolumeSource: v1.VolumeSource{
+						EmptyDir: &v1.EmptyDirVolumeSource{},
+					}},
+				},
+				InitContainers: []v1.Container{
+					{EnvFrom: []v1.EnvFromSource{
+						{SecretRef: &v1.SecretEnvSource{LocalObjectReference: v1.LocalObjectReference{Name: "init-secret"}}},
+					}},
+				},
+				Containers: []v1.Container{
+					{
+						Env: []v1.EnvVar{
+							{Name: "PLAIN", Value: "value"},
+							{Name: "FROM_CM", ValueFrom: &v1.EnvVarSource{
+								ConfigMapKeyRef: &v1.ConfigMapKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "env-cm"}, Key: "k"},
+							}},
+							{Name: "FROM_SECRET", ValueFrom: &v1.EnvVarSource{
+								SecretKeyRef: &v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "vol-secret"}, Key: "k"},
+							}},
+						},
+						EnvFrom: []v1.EnvFromSource{
+							{ConfigMapRef: &v1.ConfigMapEnvSource{LocalObjectReference: v1.LocalObjectReference{Name: "env-from-cm"}}},
+						},
+					},
+				},
+			},
+			[]string{"env-cm", "env-from-cm", "vol-cm"},
+			[]string{"init-secret", "vol-secret"},
+		},
+	}
+
+	for _, tc := range cases {
+		configMaps, secrets := podSpecConfigReferences(tc.Input)
+		if !reflect.DeepEqual(configMaps, tc.ExpectedConfigMaps) {
+			t.Fatalf("Unexpected config maps.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedConfigMaps, configMaps)
+		}
+		if !reflect.DeepEqual(secrets, tc.ExpectedSecrets) {
+			t.Fatalf("Unexpected secrets.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedSecrets, secrets)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package kubernetes
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	authv1 "k8s.io/api/authorization/v1"
+)
+
+func dataSourceKubernetesAccessReview() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKubernetesAccessReviewRead,
+
+		Schema: map[string]*schema.Schema{
+			"verb": {
+				Type:        schema.TypeString,
+				Description: "Verb to review, e.g. `get`, `list`, `create`, `patch` or `delete`. `*` means all verbs.",
+				Required:    true,
+			},
+			"group": {
+				Type:        schema.TypeString,
+				Description: "API group of the resource, e.g. `apps`. Empty for the core API group, `*` means all groups.",
+				Optional:    true,
+			},
+			"resource": {
+				Type:        schema.TypeString,
+				Description: "Plural name of the resource, e.g. `deployments`. `*` means all resources.",
+				Required:    true,
+			},
+			"subresource": {
+				Type:        schema.TypeString,
+				Description: "Subresource, e.g. `scale` or `log`.",
+				Optional:    true,
+			},
+			"namespace": {
+				Type:        schema.TypeString,
+				Description: "Namespace of the resource. Empty means all namespaces for namespaced resources.",
+				Optional:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "Name of the resource. Empty means all names.",
+				Optional:    true,
+			},
+			"allowed": {
+				Type:        schema.TypeBool,
+				Description: "Whether the provider's identity is allowed to perform the action.",
+				Computed:    true,
+			},
+			"reason": {
+				Type:        schema.TypeString,
+				Description: "Reason given by the authorizer for its decision, if any.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceKubernetesAccessReviewRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubernetesProvider).conn
+
+	attrs := authv1.ResourceAttributes{
+		Verb:        d.Get("verb").(string),
+		Group:       d.Get("group").(string),
+		Resource:    d.Get("resource").(string),
+		Subresource: d.Get("subresource").(string),
+		Namespace:   d.Get("namespace").(string),
+		Name:        d.Get("name").(string),
+	}
+	status, err := selfSubjectAccessReview(conn, attrs)
+	if err != nil {
+		return fmt.Errorf("Failed to review access: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(fmt.Sprintf("%#v", attrs))))
+	d.Set("allowed", status.Allowed)
+	d.Set("reason", status.Reason)
+
+	return nil
+}
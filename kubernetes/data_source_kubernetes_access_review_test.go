@@ -0,0 +1,32 @@
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccKubernetesDataSourceAccessReview_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceAccessReviewConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kubernetes_access_review.test", "allowed", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesDataSourceAccessReviewConfig_basic() string {
+	return `
+data "kubernetes_access_review" "test" {
+	verb      = "get"
+	resource  = "configmaps"
+	namespace = "default"
+}
+`
+}
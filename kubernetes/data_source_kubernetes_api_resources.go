@@ -0,0 +1,99 @@
+package kubernetes
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceKubernetesAPIResources() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKubernetesAPIResourcesRead,
+
+		Schema: map[string]*schema.Schema{
+			"group_versions": {
+				Type:        schema.TypeList,
+				Description: "Group versions served by the Kubernetes API server, e.g. `v1` or `apps/v1`.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"resources": {
+				Type:        schema.TypeList,
+				Description: "Resources served by the Kubernetes API server, one entry per group version and resource.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group_version": {
+							Type:        schema.TypeString,
+							Description: "Group version serving the resource, e.g. `apps/v1`.",
+							Computed:    true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Plural name of the resource, e.g. `deployments`. Subresources are named `<resource>/<subresource>`, e.g. `pods/log`.",
+							Computed:    true,
+						},
+						"kind": {
+							Type:        schema.TypeString,
+							Description: "Kind of the resource, e.g. `Deployment`.",
+							Computed:    true,
+						},
+						"namespaced": {
+							Type:        schema.TypeBool,
+							Description: "Whether the resource is namespaced.",
+							Computed:    true,
+						},
+						"verbs": {
+							Type:        schema.TypeList,
+							Description: "Verbs supported by the resource, e.g. `get`, `list` or `watch`.",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKubernetesAPIResourcesRead(d *schema.ResourceData, meta interface{}) error {
+	discoClient := meta.(*kubernetesProvider).discoClient
+
+	log.Printf("[INFO] Reading API resources")
+	resLists, err := discoClient.ServerResources()
+	if err != nil {
+		return fmt.Errorf("Failed to retrieve API resources: %s", err)
+	}
+
+	groupVersions := make([]string, 0, len(resLists))
+	resources := make([]interface{}, 0)
+	for _, list := range resLists {
+		groupVersions = append(groupVersions, list.GroupVersion)
+		for _, r := range list.APIResources {
+			resources = append(resources, map[string]interface{}{
+				"group_version": list.GroupVersion,
+				"name":          r.Name,
+				"kind":          r.Kind,
+				"namespaced":    r.Namespaced,
+				"verbs":         []string(r.Verbs),
+			})
+		}
+	}
+	log.Printf("[INFO] Received %d resources in %d group versions", len(resources), len(groupVersions))
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(strings.Join(groupVersions, ","))))
+
+	err = d.Set("group_versions", groupVersions)
+	if err != nil {
+		return err
+	}
+	err = d.Set("resources", resources)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
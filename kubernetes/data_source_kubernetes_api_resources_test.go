@@ -0,0 +1,61 @@
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccKubernetesDataSourceAPIResources_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceAPIResourcesConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.kubernetes_api_resources.test", "group_versions.#"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_api_resources.test", "resources.#"),
+					testAccCheckKubernetesAPIResourcesContains("data.kubernetes_api_resources.test", "v1", "configmaps", "ConfigMap", true),
+					testAccCheckKubernetesAPIResourcesContains("data.kubernetes_api_resources.test", "v1", "namespaces", "Namespace", false),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesAPIResourcesContains(n, groupVersion, name, kind string, namespaced bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		attrs := rs.Primary.Attributes
+
+		for i := 0; ; i++ {
+			prefix := fmt.Sprintf("resources.%d.", i)
+			if _, ok := attrs[prefix+"name"]; !ok {
+				break
+			}
+			if attrs[prefix+"group_version"] != groupVersion || attrs[prefix+"name"] != name {
+				continue
+			}
+			if attrs[prefix+"kind"] != kind {
+				return fmt.Errorf("Expected %s %s to be of kind %q, got %q", groupVersion, name, kind, attrs[prefix+"kind"])
+			}
+			if attrs[prefix+"namespaced"] != fmt.Sprintf("%t", namespaced) {
+				return fmt.Errorf("Expected %s %s to have namespaced %t, got %s", groupVersion, name, namespaced, attrs[prefix+"namespaced"])
+			}
+			return nil
+		}
+		return fmt.Errorf("Resource %s not found in group version %s", name, groupVersion)
+	}
+}
+
+func testAccKubernetesDataSourceAPIResourcesConfig_basic() string {
+	return `
+data "kubernetes_api_resources" "test" {}
+`
+}
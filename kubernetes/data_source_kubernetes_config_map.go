@@ -0,0 +1,66 @@
+package kubernetes
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"k8s.io/apimachinery/pkg/api/errors"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func dataSourceKubernetesConfigMap() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKubernetesConfigMapRead,
+
+		Schema: map[string]*schema.Schema{
+			"metadata": namespacedMetadataSchema("config map", false),
+			"data": {
+				Type:        schema.TypeMap,
+				Description: "A map of the configuration data.",
+				Computed:    true,
+			},
+			"binary_data": {
+				Type:        schema.TypeMap,
+				Description: "A map of the base64 encoded binary configuration data.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceKubernetesConfigMapRead(d *schema.ResourceData, meta interface{}) error {
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
+
+	om := meta_v1.ObjectMeta{
+		Namespace: metadataNamespace(d, kp.metadataSettings),
+		Name:      d.Get("metadata.0.name").(string),
+	}
+
+	log.Printf("[INFO] Reading config map %s", om.Name)
+	cfgMap, err := conn.CoreV1().ConfigMaps(om.Namespace).Get(om.Name, meta_v1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return fmt.Errorf("Config map %q not found in namespace %q", om.Name, om.Namespace)
+		}
+		return err
+	}
+	log.Printf("[INFO] Received config map: %#v", cfgMap)
+
+	d.SetId(buildId(cfgMap.ObjectMeta))
+
+	err = d.Set("metadata", flattenMetadata(cfgMap.ObjectMeta, d, kp.metadataSettings))
+	if err != nil {
+		return err
+	}
+	d.Set("data", cfgMap.Data)
+
+	binaryData, err := getConfigMapBinaryData(conn, om.Namespace, om.Name)
+	if err != nil {
+		return err
+	}
+	d.Set("binary_data", base64EncodeByteMap(binaryData))
+
+	return nil
+}
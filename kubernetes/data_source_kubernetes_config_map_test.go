@@ -0,0 +1,70 @@
+package kubernetes
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccKubernetesDataSourceConfigMap_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceConfigMapConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kubernetes_config_map.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttr("data.kubernetes_config_map.test", "metadata.0.namespace", "default"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_config_map.test", "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_config_map.test", "metadata.0.uid"),
+					resource.TestCheckResourceAttr("data.kubernetes_config_map.test", "metadata.0.labels.TestLabelOne", "one"),
+					resource.TestCheckResourceAttr("data.kubernetes_config_map.test", "data.%", "2"),
+					resource.TestCheckResourceAttr("data.kubernetes_config_map.test", "data.one", "first"),
+					resource.TestCheckResourceAttr("data.kubernetes_config_map.test", "data.two", "second"),
+					resource.TestCheckResourceAttr("data.kubernetes_config_map.test", "binary_data.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesDataSourceConfigMap_notFound(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubernetesDataSourceConfigMapConfig_notFound(name),
+				ExpectError: regexp.MustCompile(fmt.Sprintf("Config map %q not found in namespace \"default\"", name)),
+			},
+		},
+	})
+}
+
+func testAccKubernetesDataSourceConfigMapConfig_basic(name string) string {
+	return testAccKubernetesConfigMapConfig_basic(name) + `
+data "kubernetes_config_map" "test" {
+	metadata {
+		name = "${kubernetes_config_map.test.metadata.0.name}"
+	}
+}
+`
+}
+
+func testAccKubernetesDataSourceConfigMapConfig_notFound(name string) string {
+	return fmt.Sprintf(`
+data "kubernetes_config_map" "test" {
+	metadata {
+		name = "%s"
+	}
+}
+`, name)
+}
@@ -0,0 +1,147 @@
+package kubernetes
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func dataSourceKubernetesNodes() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKubernetesNodesRead,
+
+		Schema: map[string]*schema.Schema{
+			"label_selector": {
+				Type:        schema.TypeList,
+				Description: "A label query over the nodes to return. All nodes are returned if omitted. More info: http://kubernetes.io/docs/user-guide/labels#label-selectors",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: labelSelectorFields(),
+				},
+			},
+			"nodes": {
+				Type:        schema.TypeList,
+				Description: "List of nodes matching the label selector.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Name of the node.",
+							Computed:    true,
+						},
+						"labels": {
+							Type:        schema.TypeMap,
+							Description: "Labels of the node.",
+							Computed:    true,
+						},
+						"annotations": {
+							Type:        schema.TypeMap,
+							Description: "Annotations of the node.",
+							Computed:    true,
+						},
+						"taints": {
+							Type:        schema.TypeList,
+							Description: "Taints of the node. More info: https://kubernetes.io/docs/concepts/configuration/taint-and-toleration/",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:        schema.TypeString,
+										Description: "The taint key.",
+										Computed:    true,
+									},
+									"value": {
+										Type:        schema.TypeString,
+										Description: "The taint value.",
+										Computed:    true,
+									},
+									"effect": {
+										Type:        schema.TypeString,
+										Description: "The effect of the taint on pods that do not tolerate it. One of `NoSchedule`, `PreferNoSchedule` or `NoExecute`.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"addresses": {
+							Type:        schema.TypeList,
+							Description: "Addresses reachable to the node.",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:        schema.TypeString,
+										Description: "Type of the address, e.g. `Hostname`, `InternalIP` or `ExternalIP`.",
+										Computed:    true,
+									},
+									"address": {
+										Type:        schema.TypeString,
+										Description: "The address.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"capacity": {
+							Type:        schema.TypeMap,
+							Description: "Total resources of the node.",
+							Computed:    true,
+						},
+						"allocatable": {
+							Type:        schema.TypeMap,
+							Description: "Resources of the node that are available for scheduling.",
+							Computed:    true,
+						},
+						"kubelet_version": {
+							Type:        schema.TypeString,
+							Description: "Kubelet version reported by the node.",
+							Computed:    true,
+						},
+						"os_image": {
+							Type:        schema.TypeString,
+							Description: "OS image reported by the node, e.g. `Debian GNU/Linux 7 (wheezy)`.",
+							Computed:    true,
+						},
+						"ready": {
+							Type:        schema.TypeBool,
+							Description: "Whether the node reports the `Ready` condition.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKubernetesNodesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubernetesProvider).conn
+
+	selector, err := metav1.LabelSelectorAsSelector(expandLabelSelector(d.Get("label_selector").([]interface{})))
+	if err != nil {
+		return fmt.Errorf("Invalid label selector: %s", err)
+	}
+
+	log.Printf("[INFO] Listing nodes matching %q", selector.String())
+	nodes, err := conn.CoreV1().Nodes().List(metav1.ListOptions{
+		LabelSelector: selector.String(),
+	})
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] Received %d nodes", len(nodes.Items))
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(selector.String())))
+
+	err = d.Set("nodes", flattenNodes(nodes.Items))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
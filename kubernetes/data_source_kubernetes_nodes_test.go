@@ -0,0 +1,65 @@
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccKubernetesDataSourceNodes_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceNodesConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.kubernetes_nodes.test", "nodes.#"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_nodes.test", "nodes.0.name"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_nodes.test", "nodes.0.kubelet_version"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_nodes.test", "nodes.0.os_image"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_nodes.test", "nodes.0.capacity.cpu"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_nodes.test", "nodes.0.allocatable.memory"),
+					resource.TestCheckResourceAttr("data.kubernetes_nodes.test", "nodes.0.ready", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesDataSourceNodes_labelSelector(t *testing.T) {
+	value := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceNodesConfig_labelSelector(value),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kubernetes_nodes.test", "nodes.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesDataSourceNodesConfig_basic() string {
+	return `
+data "kubernetes_nodes" "test" {}
+`
+}
+
+func testAccKubernetesDataSourceNodesConfig_labelSelector(value string) string {
+	return fmt.Sprintf(`
+data "kubernetes_nodes" "test" {
+	label_selector {
+		match_labels {
+			TestLabelOne = "%s"
+		}
+	}
+}
+`, value)
+}
@@ -0,0 +1,147 @@
+package kubernetes
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func dataSourceKubernetesPods() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKubernetesPodsRead,
+
+		Schema: map[string]*schema.Schema{
+			"namespace": {
+				Type:        schema.TypeString,
+				Description: "Namespace to list the pods in. Pods of all namespaces are listed if set to an empty string. Defaults to the namespace of the provider.",
+				Optional:    true,
+			},
+			"label_selector": {
+				Type:        schema.TypeList,
+				Description: "A label query over the pods to return. More info: http://kubernetes.io/docs/user-guide/labels#label-selectors",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: labelSelectorFields(),
+				},
+			},
+			"field_selector": {
+				Type:         schema.TypeString,
+				Description:  "A field query over the pods to return, e.g. `status.phase=Running` or `spec.nodeName=node-1`. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/field-selectors/",
+				Optional:     true,
+				ValidateFunc: validateFieldSelector,
+			},
+			"pods": {
+				Type:        schema.TypeList,
+				Description: "List of pods matching the selectors.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Name of the pod.",
+							Computed:    true,
+						},
+						"namespace": {
+							Type:        schema.TypeString,
+							Description: "Namespace of the pod.",
+							Computed:    true,
+						},
+						"labels": {
+							Type:        schema.TypeMap,
+							Description: "Labels of the pod.",
+							Computed:    true,
+						},
+						"phase": {
+							Type:        schema.TypeString,
+							Description: "Phase of the pod, one of `Pending`, `Running`, `Succeeded`, `Failed` or `Unknown`. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle/#pod-phase",
+							Computed:    true,
+						},
+						"pod_ip": {
+							Type:        schema.TypeString,
+							Description: "IP address allocated to the pod. Empty if not yet allocated.",
+							Computed:    true,
+						},
+						"host_ip": {
+							Type:        schema.TypeString,
+							Description: "IP address of the node the pod is scheduled to. Empty if not yet scheduled.",
+							Computed:    true,
+						},
+						"node_name": {
+							Type:        schema.TypeString,
+							Description: "Name of the node the pod is scheduled to. Empty if not yet scheduled.",
+							Computed:    true,
+						},
+						"container_status": {
+							Type:        schema.TypeList,
+							Description: "Statuses of the containers of the pod.",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Description: "Name of the container.",
+										Computed:    true,
+									},
+									"ready": {
+										Type:        schema.TypeBool,
+										Description: "Whether the container has passed its readiness probe.",
+										Computed:    true,
+									},
+									"restart_count": {
+										Type:        schema.TypeInt,
+										Description: "Number of times the container has been restarted.",
+										Computed:    true,
+									},
+									"image": {
+										Type:        schema.TypeString,
+										Description: "Image the container is running.",
+										Computed:    true,
+									},
+									"image_id": {
+										Type:        schema.TypeString,
+										Description: "ID of the image the container is running, usually including its digest.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKubernetesPodsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubernetesProvider).conn
+
+	namespace := d.Get("namespace").(string)
+	fieldSelector := d.Get("field_selector").(string)
+	selector, err := metav1.LabelSelectorAsSelector(expandLabelSelector(d.Get("label_selector").([]interface{})))
+	if err != nil {
+		return fmt.Errorf("Invalid label selector: %s", err)
+	}
+
+	log.Printf("[INFO] Listing pods in namespace %q matching labels %q and fields %q", namespace, selector.String(), fieldSelector)
+	pods, err := conn.CoreV1().Pods(namespace).List(metav1.ListOptions{
+		LabelSelector: selector.String(),
+		FieldSelector: fieldSelector,
+	})
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] Received %d pods", len(pods.Items))
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(namespace+"/"+selector.String()+"/"+fieldSelector)))
+
+	err = d.Set("pods", flattenPods(pods.Items))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccKubernetesDataSourcePods_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourcePodsConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kubernetes_pods.test", "pods.#", "1"),
+					resource.TestCheckResourceAttr("data.kubernetes_pods.test", "pods.0.name", name),
+					resource.TestCheckResourceAttr("data.kubernetes_pods.test", "pods.0.namespace", "default"),
+					resource.TestCheckResourceAttr("data.kubernetes_pods.test", "pods.0.labels.%", "1"),
+					resource.TestCheckResourceAttr("data.kubernetes_pods.test", "pods.0.labels.app", name),
+					resource.TestCheckResourceAttr("data.kubernetes_pods.test", "pods.0.phase", "Running"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_pods.test", "pods.0.pod_ip"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_pods.test", "pods.0.host_ip"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_pods.test", "pods.0.node_name"),
+					resource.TestCheckResourceAttr("data.kubernetes_pods.test", "pods.0.container_status.#", "1"),
+					resource.TestCheckResourceAttr("data.kubernetes_pods.test", "pods.0.container_status.0.name", "containername"),
+					resource.TestCheckResourceAttr("data.kubernetes_pods.test", "pods.0.container_status.0.ready", "true"),
+					resource.TestCheckResourceAttr("data.kubernetes_pods.test", "pods.0.container_status.0.restart_count", "0"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_pods.test", "pods.0.container_status.0.image_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesDataSourcePods_fieldSelector(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourcePodsConfig_fieldSelector(name, "Running"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kubernetes_pods.test", "pods.#", "1"),
+					resource.TestCheckResourceAttr("data.kubernetes_pods.test", "pods.0.name", name),
+				),
+			},
+			{
+				Config: testAccKubernetesDataSourcePodsConfig_fieldSelector(name, "Failed"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kubernetes_pods.test", "pods.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesDataSourcePodsConfig_pod(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_pod" "test" {
+	metadata {
+		name = "%s"
+		labels {
+			app = "%s"
+		}
+	}
+	spec {
+		container {
+			image = "nginx:1.7.9"
+			name  = "containername"
+		}
+	}
+}
+`, name, name)
+}
+
+func testAccKubernetesDataSourcePodsConfig_basic(name string) string {
+	return testAccKubernetesDataSourcePodsConfig_pod(name) + `
+data "kubernetes_pods" "test" {
+	label_selector {
+		match_labels {
+			app = "${kubernetes_pod.test.metadata.0.labels.app}"
+		}
+	}
+}
+`
+}
+
+func testAccKubernetesDataSourcePodsConfig_fieldSelector(name, phase string) string {
+	return testAccKubernetesDataSourcePodsConfig_pod(name) + fmt.Sprintf(`
+data "kubernetes_pods" "test" {
+	namespace      = "${kubernetes_pod.test.metadata.0.namespace}"
+	field_selector = "metadata.name=%s,status.phase=%s"
+}
+`, name, phase)
+}
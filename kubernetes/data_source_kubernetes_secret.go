@@ -0,0 +1,62 @@
+package kubernetes
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"k8s.io/apimachinery/pkg/api/errors"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func dataSourceKubernetesSecret() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKubernetesSecretRead,
+
+		Schema: map[string]*schema.Schema{
+			"metadata": namespacedMetadataSchema("secret", false),
+			"data": {
+				Type:        schema.TypeMap,
+				Description: "A map of the secret data.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Description: "Type of secret",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceKubernetesSecretRead(d *schema.ResourceData, meta interface{}) error {
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
+
+	om := meta_v1.ObjectMeta{
+		Namespace: metadataNamespace(d, kp.metadataSettings),
+		Name:      d.Get("metadata.0.name").(string),
+	}
+
+	log.Printf("[INFO] Reading secret %s", om.Name)
+	secret, err := conn.CoreV1().Secrets(om.Namespace).Get(om.Name, meta_v1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return fmt.Errorf("Secret %q not found in namespace %q", om.Name, om.Namespace)
+		}
+		return err
+	}
+	log.Printf("[INFO] Received secret: %s", secret.Name)
+
+	d.SetId(buildId(secret.ObjectMeta))
+
+	err = d.Set("metadata", flattenMetadata(secret.ObjectMeta, d, kp.metadataSettings))
+	if err != nil {
+		return err
+	}
+	d.Set("data", byteMapToStringMap(secret.Data))
+	d.Set("type", secret.Type)
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package kubernetes
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccKubernetesDataSourceSecret_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceSecretConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kubernetes_secret.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttr("data.kubernetes_secret.test", "metadata.0.namespace", "default"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_secret.test", "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_secret.test", "metadata.0.uid"),
+					resource.TestCheckResourceAttr("data.kubernetes_secret.test", "metadata.0.labels.TestLabelOne", "one"),
+					resource.TestCheckResourceAttr("data.kubernetes_secret.test", "data.%", "2"),
+					resource.TestCheckResourceAttr("data.kubernetes_secret.test", "data.one", "first"),
+					resource.TestCheckResourceAttr("data.kubernetes_secret.test", "data.two", "second"),
+					resource.TestCheckResourceAttr("data.kubernetes_secret.test", "type", "Opaque"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesDataSourceSecret_notFound(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubernetesDataSourceSecretConfig_notFound(name),
+				ExpectError: regexp.MustCompile(fmt.Sprintf("Secret %q not found in namespace \"default\"", name)),
+			},
+		},
+	})
+}
+
+func testAccKubernetesDataSourceSecretConfig_basic(name string) string {
+	return testAccKubernetesSecretConfig_basic(name) + `
+data "kubernetes_secret" "test" {
+	metadata {
+		name = "${kubernetes_secret.test.metadata.0.name}"
+	}
+}
+`
+}
+
+func testAccKubernetesDataSourceSecretConfig_notFound(name string) string {
+	return fmt.Sprintf(`
+data "kubernetes_secret" "test" {
+	metadata {
+		name = "%s"
+	}
+}
+`, name)
+}
@@ -0,0 +1,56 @@
+package kubernetes
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceKubernetesServerVersion() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKubernetesServerVersionRead,
+
+		Schema: map[string]*schema.Schema{
+			"major": {
+				Type:        schema.TypeString,
+				Description: "Major version of the Kubernetes API server, e.g. `1`.",
+				Computed:    true,
+			},
+			"minor": {
+				Type:        schema.TypeString,
+				Description: "Minor version of the Kubernetes API server, e.g. `10`. Some distributions append a `+` to it.",
+				Computed:    true,
+			},
+			"git_version": {
+				Type:        schema.TypeString,
+				Description: "Full version of the Kubernetes API server, e.g. `v1.10.3`.",
+				Computed:    true,
+			},
+			"platform": {
+				Type:        schema.TypeString,
+				Description: "Platform the Kubernetes API server runs on, e.g. `linux/amd64`.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceKubernetesServerVersionRead(d *schema.ResourceData, meta interface{}) error {
+	discoClient := meta.(*kubernetesProvider).discoClient
+
+	log.Printf("[INFO] Reading server version")
+	info, err := discoClient.ServerVersion()
+	if err != nil {
+		return fmt.Errorf("Failed to retrieve server version: %s", err)
+	}
+	log.Printf("[INFO] Received server version: %#v", info)
+
+	d.SetId(info.GitVersion)
+	d.Set("major", info.Major)
+	d.Set("minor", info.Minor)
+	d.Set("git_version", info.GitVersion)
+	d.Set("platform", info.Platform)
+
+	return nil
+}
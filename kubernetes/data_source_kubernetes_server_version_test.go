@@ -0,0 +1,32 @@
+package kubernetes
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccKubernetesDataSourceServerVersion_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceServerVersionConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kubernetes_server_version.test", "major", "1"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_server_version.test", "minor"),
+					resource.TestMatchResourceAttr("data.kubernetes_server_version.test", "git_version", regexp.MustCompile(`^v1\.`)),
+					resource.TestCheckResourceAttrSet("data.kubernetes_server_version.test", "platform"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesDataSourceServerVersionConfig_basic() string {
+	return `
+data "kubernetes_server_version" "test" {}
+`
+}
@@ -35,6 +35,16 @@ func dataSourceKubernetesService() *schema.Resource {
 							Description: "The external reference that kubedns or equivalent will return as a CNAME record for this service. No proxying will be involved. Must be a valid DNS name and requires `type` to be `ExternalName`.",
 							Computed:    true,
 						},
+						"external_traffic_policy": {
+							Type:        schema.TypeString,
+							Description: "Denotes if this service desires to route external traffic to node-local or cluster-wide endpoints. `Local` preserves the client source IP and avoids a second hop for `LoadBalancer` and `NodePort` type services, but risks potentially imbalanced traffic spreading. `Cluster` obscures the client source IP and may cause a second hop to another node, but should have good overall load-spreading. More info: https://kubernetes.io/docs/tutorials/services/source-ip/",
+							Computed:    true,
+						},
+						"health_check_node_port": {
+							Type:        schema.TypeInt,
+							Description: "The node port on which the service health check is served. Only applies to `type = LoadBalancer` with `external_traffic_policy = Local`.",
+							Computed:    true,
+						},
 						"load_balancer_ip": {
 							Type:        schema.TypeString,
 							Description: "Only applies to `type = LoadBalancer`. LoadBalancer will get created with the IP specified in this field. This feature depends on whether the underlying cloud-provider supports specifying this field when a load balancer is created. This field will be ignored if the cloud-provider does not support the feature.",
@@ -82,6 +92,11 @@ func dataSourceKubernetesService() *schema.Resource {
 								},
 							},
 						},
+						"publish_not_ready_addresses": {
+							Type:        schema.TypeBool,
+							Description: "When set to true, indicates that DNS implementations must publish the `not_ready_addresses` of subsets for the endpoints associated with the service.",
+							Computed:    true,
+						},
 						"selector": {
 							Type:        schema.TypeMap,
 							Description: "Route service traffic to pods with label keys and values matching this selector. Only applies to types `ClusterIP`, `NodePort`, and `LoadBalancer`. More info: http://kubernetes.io/docs/user-guide/services#overview",
@@ -92,6 +107,29 @@ func dataSourceKubernetesService() *schema.Resource {
 							Description: "Used to maintain session affinity. Supports `ClientIP` and `None`. Defaults to `None`. More info: http://kubernetes.io/docs/user-guide/services#virtual-ips-and-service-proxies",
 							Computed:    true,
 						},
+						"session_affinity_config": {
+							Type:        schema.TypeList,
+							Description: "Contains the configurations of session affinity.",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"client_ip": {
+										Type:        schema.TypeList,
+										Description: "Contains the configurations of client IP based session affinity.",
+										Computed:    true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"timeout_seconds": {
+													Type:        schema.TypeInt,
+													Description: "The seconds of sticky session time.",
+													Computed:    true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 						"type": {
 							Type:        schema.TypeString,
 							Description: "Determines how the service is exposed. Defaults to `ClusterIP`. Valid options are `ExternalName`, `ClusterIP`, `NodePort`, and `LoadBalancer`. `ExternalName` maps to the specified `external_name`. More info: http://kubernetes.io/docs/user-guide/services#overview",
@@ -122,7 +160,7 @@ func dataSourceKubernetesService() *schema.Resource {
 
 func dataSourceKubernetesServiceRead(d *schema.ResourceData, meta interface{}) error {
 	om := meta_v1.ObjectMeta{
-		Namespace: d.Get("metadata.0.namespace").(string),
+		Namespace: metadataNamespace(d, meta.(*kubernetesProvider).metadataSettings),
 		Name:      d.Get("metadata.0.name").(string),
 	}
 	d.SetId(buildId(om))
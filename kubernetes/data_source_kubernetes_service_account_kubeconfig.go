@@ -0,0 +1,180 @@
+package kubernetes
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+func dataSourceKubernetesServiceAccountKubeconfig() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKubernetesServiceAccountKubeconfigRead,
+
+		Schema: map[string]*schema.Schema{
+			"metadata": namespacedMetadataSchema("service account", false),
+			"secret_name": {
+				Type:        schema.TypeString,
+				Description: "Name of the token secret of the service account, usually the `default_secret_name` of a `kubernetes_service_account` resource. Defaults to the first token secret of the service account.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"cluster_name": {
+				Type:        schema.TypeString,
+				Description: "Name of the cluster in the rendered kubeconfig.",
+				Optional:    true,
+				Default:     "kubernetes",
+			},
+			"kubeconfig": {
+				Type:        schema.TypeString,
+				Description: "Kubeconfig authenticating as the service account against the provider's API server, in YAML.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func dataSourceKubernetesServiceAccountKubeconfigRead(d *schema.ResourceData, meta interface{}) error {
+	provider := meta.(*kubernetesProvider)
+	conn := provider.conn
+
+	namespace := metadataNamespace(d, provider.metadataSettings)
+	name := d.Get("metadata.0.name").(string)
+
+	log.Printf("[INFO] Reading service account %s", name)
+	svcAcc, err := conn.CoreV1().ServiceAccounts(namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return fmt.Errorf("Service account %q not found in namespace %q", name, namespace)
+		}
+		return err
+	}
+	log.Printf("[INFO] Received service account: %#v", svcAcc)
+
+	// The token controller populates the secret asynchronously,
+	// so it may not be referenced or filled in yet
+	var secret *api.Secret
+	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+		var err error
+		secretName := d.Get("secret_name").(string)
+		if secretName == "" {
+			svcAcc, err = conn.CoreV1().ServiceAccounts(namespace).Get(name, metav1.GetOptions{})
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+			secret, err = findServiceAccountTokenSecret(conn.CoreV1().Secrets(namespace).Get, svcAcc)
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+			if secret == nil {
+				return resource.RetryableError(fmt.Errorf("Waiting for token secret of service account %q to appear", name))
+			}
+		} else {
+			secret, err = conn.CoreV1().Secrets(namespace).Get(secretName, metav1.GetOptions{})
+			if err != nil {
+				if errors.IsNotFound(err) {
+					return resource.RetryableError(fmt.Errorf("Waiting for token secret %q to appear", secretName))
+				}
+				return resource.NonRetryableError(err)
+			}
+			if secret.Type != api.SecretTypeServiceAccountToken {
+				return resource.NonRetryableError(fmt.Errorf("Secret %q is of type %q, expected %q", secretName, secret.Type, api.SecretTypeServiceAccountToken))
+			}
+			if !isServiceAccountTokenSecret(secret, svcAcc) {
+				return resource.NonRetryableError(fmt.Errorf("Secret %q holds the token of service account %q, not %q",
+					secretName, secret.Annotations[api.ServiceAccountNameKey], name))
+			}
+		}
+		if len(secret.Data[api.ServiceAccountTokenKey]) == 0 {
+			return resource.RetryableError(fmt.Errorf("Waiting for token of secret %q to be populated", secret.Name))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] Received token secret: %s", secret.Name)
+
+	cluster := clientcmdapi.NewCluster()
+	cluster.Server = provider.cfg.Host
+	if provider.cfg.Insecure {
+		cluster.InsecureSkipTLSVerify = true
+	} else {
+		caData := provider.cfg.CAData
+		if len(caData) == 0 && provider.cfg.CAFile != "" {
+			caData, err = ioutil.ReadFile(provider.cfg.CAFile)
+			if err != nil {
+				return fmt.Errorf("Failed to read CA file %q: %s", provider.cfg.CAFile, err)
+			}
+		}
+		if len(caData) == 0 {
+			caData = secret.Data[api.ServiceAccountRootCAKey]
+		}
+		cluster.CertificateAuthorityData = caData
+	}
+
+	authInfo := clientcmdapi.NewAuthInfo()
+	authInfo.Token = string(secret.Data[api.ServiceAccountTokenKey])
+
+	clusterName := d.Get("cluster_name").(string)
+	context := clientcmdapi.NewContext()
+	context.Cluster = clusterName
+	context.AuthInfo = name
+	context.Namespace = namespace
+
+	config := clientcmdapi.NewConfig()
+	config.Clusters[clusterName] = cluster
+	config.AuthInfos[name] = authInfo
+	config.Contexts[name] = context
+	config.CurrentContext = name
+
+	kubeconfig, err := clientcmd.Write(*config)
+	if err != nil {
+		return fmt.Errorf("Failed to render kubeconfig: %s", err)
+	}
+
+	d.SetId(buildId(svcAcc.ObjectMeta))
+
+	err = d.Set("metadata", flattenMetadata(svcAcc.ObjectMeta, d, provider.metadataSettings))
+	if err != nil {
+		return err
+	}
+	d.Set("secret_name", secret.Name)
+	d.Set("kubeconfig", string(kubeconfig))
+
+	return nil
+}
+
+// findServiceAccountTokenSecret returns the first token secret of the service
+// account it references, or nil if the token controller hasn't created one yet.
+func findServiceAccountTokenSecret(get func(string, metav1.GetOptions) (*api.Secret, error), svcAcc *api.ServiceAccount) (*api.Secret, error) {
+	for _, ref := range svcAcc.Secrets {
+		secret, err := get(ref.Name, metav1.GetOptions{})
+		if err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		if isServiceAccountTokenSecret(secret, svcAcc) {
+			return secret, nil
+		}
+	}
+	return nil, nil
+}
+
+// isServiceAccountTokenSecret tells whether the secret holds a token of the
+// service account, as any secret may be referenced by it.
+func isServiceAccountTokenSecret(secret *api.Secret, svcAcc *api.ServiceAccount) bool {
+	return secret.Type == api.SecretTypeServiceAccountToken &&
+		secret.Annotations[api.ServiceAccountNameKey] == svcAcc.Name
+}
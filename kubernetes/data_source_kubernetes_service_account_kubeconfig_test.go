@@ -0,0 +1,106 @@
+package kubernetes
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sschema "k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestFindServiceAccountTokenSecret(t *testing.T) {
+	tokenSecret := func(name, svcAcc string) *api.Secret {
+		return &api.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Annotations: map[string]string{api.ServiceAccountNameKey: svcAcc},
+			},
+			Type: api.SecretTypeServiceAccountToken,
+		}
+	}
+	secrets := map[string]*api.Secret{
+		"opaque":       {ObjectMeta: metav1.ObjectMeta{Name: "opaque"}, Type: api.SecretTypeOpaque},
+		"foreign":      tokenSecret("foreign", "other"),
+		"deploy-token": tokenSecret("deploy-token", "deploy"),
+	}
+	get := func(name string, opts metav1.GetOptions) (*api.Secret, error) {
+		if secret, ok := secrets[name]; ok {
+			return secret, nil
+		}
+		return nil, errors.NewNotFound(k8sschema.GroupResource{Resource: "secrets"}, name)
+	}
+
+	testCases := []struct {
+		Refs     []string
+		Expected string
+	}{
+		{[]string{}, ""},
+		{[]string{"missing", "opaque", "foreign"}, ""},
+		{[]string{"foreign", "deploy-token"}, "deploy-token"},
+		{[]string{"missing", "deploy-token"}, "deploy-token"},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			svcAcc := &api.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "deploy"}}
+			for _, ref := range tc.Refs {
+				svcAcc.Secrets = append(svcAcc.Secrets, api.ObjectReference{Name: ref})
+			}
+			secret, err := findServiceAccountTokenSecret(get, svcAcc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var name string
+			if secret != nil {
+				name = secret.Name
+			}
+			if name != tc.Expected {
+				t.Fatalf("Expected token secret %q, given %q", tc.Expected, name)
+			}
+		})
+	}
+}
+
+func TestAccKubernetesDataSourceServiceAccountKubeconfig_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceServiceAccountKubeconfigConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kubernetes_service_account_kubeconfig.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttrPair("data.kubernetes_service_account_kubeconfig.test", "secret_name",
+						"kubernetes_service_account.test", "default_secret_name"),
+					resource.TestMatchResourceAttr("data.kubernetes_service_account_kubeconfig.test", "kubeconfig", regexp.MustCompile(`(?m)^\s+token: \S+$`)),
+					resource.TestMatchResourceAttr("data.kubernetes_service_account_kubeconfig.test", "kubeconfig", regexp.MustCompile(`(?m)^current-context: `+name+`$`)),
+					resource.TestMatchResourceAttr("data.kubernetes_service_account_kubeconfig.test", "kubeconfig", regexp.MustCompile(`(?m)^\s+server: \S+$`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesDataSourceServiceAccountKubeconfigConfig_basic(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_service_account" "test" {
+	metadata {
+		name = "%s"
+	}
+}
+
+data "kubernetes_service_account_kubeconfig" "test" {
+	metadata {
+		name      = "${kubernetes_service_account.test.metadata.0.name}"
+		namespace = "${kubernetes_service_account.test.metadata.0.namespace}"
+	}
+	secret_name = "${kubernetes_service_account.test.default_secret_name}"
+}
+`, name)
+}
@@ -1,6 +1,8 @@
 package kubernetes
 
 import (
+	"log"
+
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -9,6 +11,11 @@ func dataSourceKubernetesStorageClass() *schema.Resource {
 		Read: dataSourceKubernetesStorageClassRead,
 		Schema: map[string]*schema.Schema{
 			"metadata": metadataSchema("storage class", false),
+			"reclaim_policy": {
+				Type:        schema.TypeString,
+				Description: "Reclaim policy to be applied to provisioned persistent volumes",
+				Computed:    true,
+			},
 			"parameters": {
 				Type:        schema.TypeMap,
 				Description: "The parameters for the provisioner that should create volumes of this storage class",
@@ -19,12 +26,60 @@ func dataSourceKubernetesStorageClass() *schema.Resource {
 				Description: "Indicates the type of the provisioner",
 				Computed:    true,
 			},
+			"volume_binding_mode": {
+				Type:        schema.TypeString,
+				Description: "Indicates when volumes should be provisioned and bound, either `Immediate` or `WaitForFirstConsumer`",
+				Computed:    true,
+			},
+			"allowed_topologies": {
+				Type:        schema.TypeList,
+				Description: "Restricts the topologies in which volumes can be provisioned, e.g. to zones",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: topologySelectorTermFields(),
+				},
+			},
+			"mount_options": {
+				Type:        schema.TypeSet,
+				Description: "Mount options of persistent volumes provisioned by this storage class",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+			},
+			"allow_volume_expansion": {
+				Type:        schema.TypeBool,
+				Description: "Whether persistent volume claims of this storage class may be expanded",
+				Computed:    true,
+			},
+			"is_default": {
+				Type:        schema.TypeBool,
+				Description: "Whether this is the default storage class of the cluster, used by claims not requesting any",
+				Computed:    true,
+			},
 		},
 	}
 }
 
 func dataSourceKubernetesStorageClassRead(d *schema.ResourceData, meta interface{}) error {
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
+
 	name := d.Get("metadata.0.name").(string)
 	d.SetId(name)
-	return resourceKubernetesStorageClassRead(d, meta)
+
+	log.Printf("[INFO] Reading storage class %s", name)
+	storageClass, err := readStorageClass(conn, name)
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return err
+	}
+	log.Printf("[INFO] Received storage class: %#v", storageClass)
+
+	err = setStorageClassAttributes(d, storageClass, kp.metadataSettings)
+	if err != nil {
+		return err
+	}
+	d.Set("is_default", isDefaultStorageClass(storageClass.ObjectMeta))
+
+	return nil
 }
@@ -32,6 +32,30 @@ func TestAccKubernetesDataSourceStorageClass_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("data.kubernetes_storage_class.test", "storage_provisioner", "kubernetes.io/gce-pd"),
 					resource.TestCheckResourceAttr("data.kubernetes_storage_class.test", "parameters.%", "1"),
 					resource.TestCheckResourceAttr("data.kubernetes_storage_class.test", "parameters.type", "pd-ssd"),
+					resource.TestCheckResourceAttr("data.kubernetes_storage_class.test", "reclaim_policy", "Retain"),
+					resource.TestCheckResourceAttr("data.kubernetes_storage_class.test", "volume_binding_mode", "Immediate"),
+					resource.TestCheckResourceAttr("data.kubernetes_storage_class.test", "allowed_topologies.#", "0"),
+					resource.TestCheckResourceAttr("data.kubernetes_storage_class.test", "mount_options.#", "0"),
+					resource.TestCheckResourceAttr("data.kubernetes_storage_class.test", "allow_volume_expansion", "false"),
+					resource.TestCheckResourceAttr("data.kubernetes_storage_class.test", "is_default", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesDataSourceStorageClass_default(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceStorageClassConfig_default(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kubernetes_storage_class.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttr("data.kubernetes_storage_class.test", "is_default", "true"),
 				),
 			},
 		},
@@ -47,3 +71,23 @@ data "kubernetes_storage_class" "test" {
 }
 `
 }
+
+func testAccKubernetesDataSourceStorageClassConfig_default(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_storage_class" "test" {
+	metadata {
+		name = "%s"
+		annotations {
+			"storageclass.kubernetes.io/is-default-class" = "true"
+		}
+	}
+	storage_provisioner = "kubernetes.io/gce-pd"
+}
+
+data "kubernetes_storage_class" "test" {
+	metadata {
+		name = "${kubernetes_storage_class.test.metadata.0.name}"
+	}
+}
+`, name)
+}
@@ -1,6 +1,11 @@
 package kubernetes
 
 import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"reflect"
+
 	"github.com/hashicorp/terraform/helper/schema"
 	"k8s.io/apimachinery/pkg/api/resource"
 )
@@ -16,3 +21,32 @@ func suppressEquivalentResourceQuantity(k, old, new string, d *schema.ResourceDa
 	}
 	return oldQ.Cmp(newQ) == 0
 }
+
+func suppressEquivalentBase64(k, old, new string, d *schema.ResourceData) bool {
+	if old == "" || new == "" {
+		return false
+	}
+	oldB, err := base64.StdEncoding.DecodeString(old)
+	if err != nil {
+		return false
+	}
+	newB, err := base64.StdEncoding.DecodeString(new)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(oldB, newB)
+}
+
+func suppressEquivalentJSON(k, old, new string, d *schema.ResourceData) bool {
+	if old == "" || new == "" {
+		return false
+	}
+	var oldJ, newJ interface{}
+	if err := json.Unmarshal([]byte(old), &oldJ); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(new), &newJ); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(oldJ, newJ)
+}
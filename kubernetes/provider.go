@@ -6,14 +6,17 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"strings"
 	"sync"
 
 	"path/filepath"
 	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/mitchellh/go-homedir"
+	authv1 "k8s.io/api/authorization/v1"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/kubernetes"
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
@@ -29,10 +32,18 @@ type kubernetesProvider struct {
 	discoveryCacheDir string
 	discoClient       *CachedDiscoveryClient
 	mu                sync.Mutex
+
+	metadataSettings metadataSettings
+
+	checkPermissions           bool
+	accessReviews              map[authv1.ResourceAttributes]*authv1.SubjectAccessReviewStatus
+	missingPermissions         []string
+	missingPermissionsReported bool
+	accessReviewsMu            sync.Mutex
 }
 
 func Provider() terraform.ResourceProvider {
-	return &schema.Provider{
+	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"host": {
 				Type:        schema.TypeString,
@@ -116,47 +127,227 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("KUBE_LOAD_CONFIG_FILE", true),
 				Description: "Load local kubeconfig.",
 			},
+			"check_permissions": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("KUBE_CHECK_PERMISSIONS", false),
+				Description: "Review the permissions needed by every planned change with a SelfSubjectAccessReview and fail the apply before it changes anything if any are missing.",
+			},
+			"namespace": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("KUBE_NAMESPACE", ""),
+				Description: "Namespace of the objects which don't set one. Defaults to the namespace of the kube config context, or `default`.",
+			},
+			"default_labels": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateLabels,
+				Description:  "Labels added to every object managed by the provider, including pod templates. Labels set on a resource take precedence.",
+			},
+			"default_annotations": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateAnnotations,
+				Description:  "Annotations added to every object managed by the provider, including pod templates. Annotations set on a resource take precedence.",
+			},
+			"ignore_labels": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.ValidateRegexp},
+				Description: "Regular expressions matching the keys of labels managed outside of Terraform. Matching labels are left out of the state unless configured and are never removed by Terraform.",
+			},
+			"ignore_annotations": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.ValidateRegexp},
+				Description: "Regular expressions matching the keys of annotations managed outside of Terraform. Matching annotations are left out of the state unless configured and are never removed by Terraform.",
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"kubernetes_service":       dataSourceKubernetesService(),
-			"kubernetes_storage_class": dataSourceKubernetesStorageClass(),
+			"kubernetes_access_review":              dataSourceKubernetesAccessReview(),
+			"kubernetes_api_resources":              dataSourceKubernetesAPIResources(),
+			"kubernetes_config_map":                 dataSourceKubernetesConfigMap(),
+			"kubernetes_nodes":                      dataSourceKubernetesNodes(),
+			"kubernetes_pods":                       dataSourceKubernetesPods(),
+			"kubernetes_secret":                     dataSourceKubernetesSecret(),
+			"kubernetes_server_version":             dataSourceKubernetesServerVersion(),
+			"kubernetes_service_account_kubeconfig": dataSourceKubernetesServiceAccountKubeconfig(),
+			"kubernetes_service":                    dataSourceKubernetesService(),
+			"kubernetes_storage_class":              dataSourceKubernetesStorageClass(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"kubernetes_cluster_role":              resourceKubernetesClusterRole(),
-			"kubernetes_cluster_role_binding":      resourceKubernetesClusterRoleBinding(),
-			"kubernetes_config_map":                resourceKubernetesConfigMap(),
-			"kubernetes_horizontal_pod_autoscaler": resourceKubernetesHorizontalPodAutoscaler(),
-			"kubernetes_job":                       resourceKubernetesJob(),
-			"kubernetes_cron_job":                  resourceKubernetesCronJob(),
-			"kubernetes_ingress":                   resourceKubernetesIngress(),
-			"kubernetes_limit_range":               resourceKubernetesLimitRange(),
-			"kubernetes_namespace":                 resourceKubernetesNamespace(),
-			"kubernetes_persistent_volume":         resourceKubernetesPersistentVolume(),
-			"kubernetes_persistent_volume_claim":   resourceKubernetesPersistentVolumeClaim(),
-			"kubernetes_pod":                       resourceKubernetesPod(),
-			"kubernetes_replication_controller":    resourceKubernetesReplicationController(),
-			"kubernetes_deployment":                resourceKubernetesDeployment(),
-			"kubernetes_daemonset":                 resourceKubernetesDaemonSet(),
-			"kubernetes_resource_quota":            resourceKubernetesResourceQuota(),
-			"kubernetes_secret":                    resourceKubernetesSecret(),
-			"kubernetes_service":                   resourceKubernetesService(),
-			"kubernetes_service_account":           resourceKubernetesServiceAccount(),
-			"kubernetes_stateful_set":              resourceKubernetesStatefulSet(),
-			"kubernetes_storage_class":             resourceKubernetesStorageClass(),
+			"kubernetes_api_service":                 resourceKubernetesAPIService(),
+			"kubernetes_certificate_signing_request": resourceKubernetesCertificateSigningRequest(),
+			"kubernetes_cluster_role":                resourceKubernetesClusterRole(),
+			"kubernetes_cluster_role_binding":        resourceKubernetesClusterRoleBinding(),
+			"kubernetes_config_map":                  resourceKubernetesConfigMap(),
+			"kubernetes_custom_resource_definition":  resourceKubernetesCustomResourceDefinition(),
+			"kubernetes_endpoints":                   resourceKubernetesEndpoints(),
+			"kubernetes_horizontal_pod_autoscaler":   resourceKubernetesHorizontalPodAutoscaler(),
+			"kubernetes_job":                         resourceKubernetesJob(),
+			"kubernetes_cron_job":                    resourceKubernetesCronJob(),
+			"kubernetes_ingress":                     resourceKubernetesIngress(),
+			"kubernetes_limit_range":                 resourceKubernetesLimitRange(),
+			"kubernetes_namespace":                   resourceKubernetesNamespace(),
+			"kubernetes_persistent_volume":           resourceKubernetesPersistentVolume(),
+			"kubernetes_persistent_volume_claim":     resourceKubernetesPersistentVolumeClaim(),
+			"kubernetes_pod":                         resourceKubernetesPod(),
+			"kubernetes_pod_preset":                  resourceKubernetesPodPreset(),
+			"kubernetes_pod_security_policy":         resourceKubernetesPodSecurityPolicy(),
+			"kubernetes_replication_controller":      resourceKubernetesReplicationController(),
+			"kubernetes_deployment":                  resourceKubernetesDeployment(),
+			"kubernetes_daemonset":                   resourceKubernetesDaemonSet(),
+			"kubernetes_resource_quota":              resourceKubernetesResourceQuota(),
+			"kubernetes_secret":                      resourceKubernetesSecret(),
+			"kubernetes_service":                     resourceKubernetesService(),
+			"kubernetes_service_account":             resourceKubernetesServiceAccount(),
+			"kubernetes_stateful_set":                resourceKubernetesStatefulSet(),
+			"kubernetes_storage_class":               resourceKubernetesStorageClass(),
 		},
 		ConfigureFunc: providerConfigure,
 	}
+
+	// The default namespace of the provider is only known once configured
+	p.DataSourcesMap["kubernetes_pods"].Schema["namespace"].DefaultFunc = func() (interface{}, error) {
+		if kp, ok := p.Meta().(*kubernetesProvider); ok {
+			return kp.metadataSettings.DefaultNamespace, nil
+		}
+		return nil, nil
+	}
+
+	for rtype, r := range p.ResourcesMap {
+		r.CustomizeDiff = customizeDiffAccessReview(rtype, r.Schema, r.CustomizeDiff)
+		for k, v := range deleteOptionsSchema() {
+			r.Schema[k] = v
+		}
+		r.Delete = deleteUnlessRetained(r.Delete)
+		r.CustomizeDiff = customizeDiffRetainOnDestroy(r.Schema, r.CustomizeDiff)
+		if r.Update == nil {
+			// Changes to the deletion settings only need to be saved
+			r.Update = schema.UpdateFunc(r.Read)
+		}
+		r.Create = failOnMissingPermissions(r.Create)
+		r.Update = failOnMissingPermissions(r.Update)
+		r.Delete = failOnMissingPermissions(r.Delete)
+		if r.Importer != nil && isNamespacedResource(r) {
+			r.Importer.State = importStateWithNamespace(r.Importer.State)
+		}
+	}
+
+	return p
+}
+
+// deleteUnlessRetained skips the deletion of objects whose resource sets
+// retain_on_destroy, only removing them from the state.
+func deleteUnlessRetained(next schema.DeleteFunc) schema.DeleteFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		if d.Get("retain_on_destroy").(bool) {
+			log.Printf("[INFO] Retaining %s, removing it from the state only", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return next(d, meta)
+	}
+}
+
+// customizeDiffRetainOnDestroy refuses to replace objects whose resource
+// sets retain_on_destroy, as Terraform deletes them the same way as on
+// destroy and the retained object would be in the way of its replacement.
+func customizeDiffRetainOnDestroy(s map[string]*schema.Schema, next schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(diff *schema.ResourceDiff, meta interface{}) error {
+		if next != nil {
+			err := next(diff, meta)
+			if err != nil {
+				return err
+			}
+		}
+		if diff.Id() == "" {
+			return nil
+		}
+		for _, key := range diff.GetChangedKeysPrefix("") {
+			if isForceNewKey(s, key) {
+				return checkRetainedReplacement(diff, key)
+			}
+		}
+		return nil
+	}
+}
+
+// forceNewUnlessRetained is used by CustomizeDiff functions in place of
+// ForceNew, which customizeDiffRetainOnDestroy doesn't get to see.
+func forceNewUnlessRetained(diff *schema.ResourceDiff, key string) error {
+	err := checkRetainedReplacement(diff, key)
+	if err != nil {
+		return err
+	}
+	return diff.ForceNew(key)
+}
+
+func checkRetainedReplacement(diff *schema.ResourceDiff, key string) error {
+	// The deletion is skipped based on the state
+	retained, _ := diff.GetChange("retain_on_destroy")
+	if retained.(bool) {
+		return fmt.Errorf("%s: changing it replaces the object, which is retained on destroy. Unset retain_on_destroy first", key)
+	}
+	return nil
+}
+
+// isForceNewKey returns whether a change of the attribute at key forces a new
+// resource. As in helper/schema, the ForceNew of a list or set only applies
+// to its count and primitive elements, not to the fields of nested blocks.
+func isForceNewKey(m map[string]*schema.Schema, key string) bool {
+	parts := strings.Split(key, ".")
+	for {
+		s, ok := m[parts[0]]
+		if !ok {
+			return false
+		}
+		r, ok := s.Elem.(*schema.Resource)
+		if !ok || len(parts) < 3 {
+			return s.ForceNew
+		}
+		// Skip the index of the list or set item
+		m, parts = r.Schema, parts[2:]
+	}
+}
+
+func isNamespacedResource(r *schema.Resource) bool {
+	m, ok := r.Schema["metadata"]
+	if !ok {
+		return false
+	}
+	elem, ok := m.Elem.(*schema.Resource)
+	if !ok {
+		return false
+	}
+	_, ok = elem.Schema["namespace"]
+	return ok
+}
+
+// importStateWithNamespace completes the IDs of imported objects which
+// leave out the namespace with the default namespace of the provider.
+func importStateWithNamespace(importer schema.StateFunc) schema.StateFunc {
+	return func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		if d.Id() != "" && !strings.Contains(d.Id(), "/") {
+			d.SetId(meta.(*kubernetesProvider).metadataSettings.DefaultNamespace + "/" + d.Id())
+		}
+		return importer(d, meta)
+	}
 }
 
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 
 	var cfg *restclient.Config
+	var configNamespace string
 	var err error
 	if d.Get("load_config_file").(bool) {
 		// Config file loading
-		cfg, err = tryLoadingConfigFile(d)
+		cfg, configNamespace, err = tryLoadingConfigFile(d)
 	}
 
 	if err != nil {
@@ -200,8 +391,28 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	}
 
 	providerInstance := &kubernetesProvider{
-		conn: k,
-		cfg:  cfg,
+		conn:             k,
+		cfg:              cfg,
+		checkPermissions: d.Get("check_permissions").(bool),
+	}
+
+	providerInstance.metadataSettings.DefaultNamespace = "default"
+	if v, ok := d.GetOk("namespace"); ok {
+		providerInstance.metadataSettings.DefaultNamespace = v.(string)
+	} else if configNamespace != "" {
+		providerInstance.metadataSettings.DefaultNamespace = configNamespace
+	}
+	log.Printf("[DEBUG] Using default namespace %q", providerInstance.metadataSettings.DefaultNamespace)
+
+	providerInstance.metadataSettings.DefaultLabels = expandStringMap(d.Get("default_labels").(map[string]interface{}))
+	providerInstance.metadataSettings.DefaultAnnotations = expandStringMap(d.Get("default_annotations").(map[string]interface{}))
+	providerInstance.metadataSettings.IgnoredLabels, err = expandRegexps(d.Get("ignore_labels").([]interface{}))
+	if err != nil {
+		return nil, fmt.Errorf("Failed to parse ignore_labels: %s", err)
+	}
+	providerInstance.metadataSettings.IgnoredAnnotations, err = expandRegexps(d.Get("ignore_annotations").([]interface{}))
+	if err != nil {
+		return nil, fmt.Errorf("Failed to parse ignore_annotations: %s", err)
 	}
 
 	err = providerInstance.prepareDiscoveryCacheClient(d)
@@ -270,10 +481,12 @@ func (p *kubernetesProvider) prepareDiscoveryCacheClient(d *schema.ResourceData)
 	return nil
 }
 
-func tryLoadingConfigFile(d *schema.ResourceData) (*restclient.Config, error) {
+// tryLoadingConfigFile loads the client configuration along with the
+// namespace of the context from the kube config file.
+func tryLoadingConfigFile(d *schema.ResourceData) (*restclient.Config, string, error) {
 	path, err := homedir.Expand(d.Get("config_path").(string))
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	loader := &clientcmd.ClientConfigLoadingRules{
@@ -311,11 +524,16 @@ func tryLoadingConfigFile(d *schema.ResourceData) (*restclient.Config, error) {
 	if err != nil {
 		if pathErr, ok := err.(*os.PathError); ok && os.IsNotExist(pathErr.Err) {
 			log.Printf("[INFO] Unable to load config file as it doesn't exist at %q", path)
-			return nil, nil
+			return nil, "", nil
 		}
-		return nil, fmt.Errorf("Failed to load config (%s%s): %s", path, ctxSuffix, err)
+		return nil, "", fmt.Errorf("Failed to load config (%s%s): %s", path, ctxSuffix, err)
+	}
+
+	namespace, _, err := cc.Namespace()
+	if err != nil {
+		return nil, "", fmt.Errorf("Failed to read namespace from config (%s%s): %s", path, ctxSuffix, err)
 	}
 
 	log.Printf("[INFO] Successfully loaded config file (%s%s)", path, ctxSuffix)
-	return cfg, nil
+	return cfg, namespace, nil
 }
@@ -1,7 +1,11 @@
 package kubernetes
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"strings"
 	"testing"
@@ -11,8 +15,11 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws"
 	"github.com/terraform-providers/terraform-provider-google/google"
+	authv1 "k8s.io/api/authorization/v1"
 	api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	restclient "k8s.io/client-go/rest"
 )
 
 var testAccProviders map[string]terraform.ResourceProvider
@@ -37,6 +44,212 @@ func TestProvider_impl(t *testing.T) {
 	var _ terraform.ResourceProvider = Provider()
 }
 
+func TestProvider_accessReviewTargets(t *testing.T) {
+	for rtype := range Provider().(*schema.Provider).ResourcesMap {
+		if _, ok := accessReviewTargets[rtype]; !ok {
+			t.Errorf("No access review target defined for %s", rtype)
+		}
+	}
+}
+
+func TestProvider_importStateWithNamespace(t *testing.T) {
+	meta := &kubernetesProvider{metadataSettings: metadataSettings{DefaultNamespace: "staging"}}
+	importer := importStateWithNamespace(schema.ImportStatePassthrough)
+
+	testCases := []struct {
+		ID       string
+		Expected string
+	}{
+		{"default/example", "default/example"},
+		{"example", "staging/example"},
+	}
+	for _, tc := range testCases {
+		d := resourceKubernetesConfigMap().Data(nil)
+		d.SetId(tc.ID)
+		result, err := importer(d, meta)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result[0].Id() != tc.Expected {
+			t.Fatalf("Expected ID %q for %q, given %q", tc.Expected, tc.ID, result[0].Id())
+		}
+	}
+}
+
+func TestProvider_retainOnDestroyReplacement(t *testing.T) {
+	r := Provider().(*schema.Provider).ResourcesMap["kubernetes_config_map"]
+	state := &terraform.InstanceState{
+		ID: "default/example",
+		Attributes: map[string]string{
+			"id":                   "default/example",
+			"metadata.#":           "1",
+			"metadata.0.name":      "example",
+			"metadata.0.namespace": "default",
+			"retain_on_destroy":    "true",
+		},
+	}
+
+	testCases := []struct {
+		Metadata    map[string]interface{}
+		ExpectError bool
+	}{
+		{map[string]interface{}{"name": "example", "labels": map[string]interface{}{"app": "web"}}, false},
+		{map[string]interface{}{"name": "renamed"}, true},
+	}
+	for _, tc := range testCases {
+		raw, err := config.NewRawConfig(map[string]interface{}{
+			"metadata":          []interface{}{tc.Metadata},
+			"retain_on_destroy": true,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = r.Diff(state, terraform.NewResourceConfig(raw), &kubernetesProvider{})
+		if tc.ExpectError && err == nil {
+			t.Fatalf("Expected an error replacing a retained object with %v", tc.Metadata)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Fatalf("Expected no error with %v, given %s", tc.Metadata, err)
+		}
+	}
+}
+
+func TestProvider_retainOnDestroyExpansion(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"metadata": {"name": "standard"}, "provisioner": "example.com/volume", "allowVolumeExpansion": true}`)
+	}))
+	defer server.Close()
+	conn, err := kubernetes.NewForConfig(&restclient.Config{Host: server.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := Provider().(*schema.Provider).ResourcesMap["kubernetes_persistent_volume_claim"]
+	state := &terraform.InstanceState{
+		ID: "default/example",
+		Attributes: map[string]string{
+			"id":                    "default/example",
+			"metadata.#":            "1",
+			"metadata.0.name":       "example",
+			"metadata.0.namespace":  "default",
+			"spec.#":                "1",
+			"spec.0.access_modes.#": "1",
+			fmt.Sprintf("spec.0.access_modes.%d", schema.HashString("ReadWriteOnce")): "ReadWriteOnce",
+			"spec.0.resources.#":                  "1",
+			"spec.0.resources.0.requests.%":       "1",
+			"spec.0.resources.0.requests.storage": "1Gi",
+			"spec.0.storage_class_name":           "standard",
+			"wait_until_bound":                    "true",
+			"retain_on_destroy":                   "true",
+		},
+	}
+
+	testCases := []struct {
+		AccessMode  string
+		Storage     string
+		ExpectError bool
+	}{
+		{"ReadWriteOnce", "2Gi", false},
+		{"ReadWriteMany", "1Gi", true},
+	}
+	for _, tc := range testCases {
+		raw, err := config.NewRawConfig(map[string]interface{}{
+			"metadata": []interface{}{map[string]interface{}{"name": "example"}},
+			"spec": []interface{}{map[string]interface{}{
+				"access_modes": []interface{}{tc.AccessMode},
+				"resources": []interface{}{map[string]interface{}{
+					"requests": map[string]interface{}{"storage": tc.Storage},
+				}},
+				"storage_class_name": "standard",
+			}},
+			"retain_on_destroy": true,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		diff, err := r.Diff(state, terraform.NewResourceConfig(raw), &kubernetesProvider{conn: conn})
+		if tc.ExpectError {
+			if err == nil {
+				t.Fatalf("Expected an error replacing the retained claim with %s", tc.AccessMode)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff.RequiresNew() {
+			t.Fatalf("Expected the retained claim to be expanded to %s in place, given %#v", tc.Storage, diff)
+		}
+	}
+}
+
+func TestProvider_accessReview(t *testing.T) {
+	// Allows everything but deleting
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var review authv1.SelfSubjectAccessReview
+		err := json.NewDecoder(req.Body).Decode(&review)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		review.Status.Allowed = review.Spec.ResourceAttributes.Verb != "delete"
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(review)
+	}))
+	defer server.Close()
+	conn, err := kubernetes.NewForConfig(&restclient.Config{Host: server.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	kp := &kubernetesProvider{conn: conn, checkPermissions: true}
+
+	testCases := []struct {
+		ResourceType string
+		Metadata     map[string]interface{}
+	}{
+		{"kubernetes_config_map", map[string]interface{}{"name": "example", "labels": map[string]interface{}{"app": "web"}}},
+		{"kubernetes_config_map", map[string]interface{}{"name": "renamed"}},
+		{"kubernetes_secret", map[string]interface{}{"name": "renamed"}},
+	}
+	for _, tc := range testCases {
+		r := Provider().(*schema.Provider).ResourcesMap[tc.ResourceType]
+		state := &terraform.InstanceState{
+			ID: "default/example",
+			Attributes: map[string]string{
+				"id":                   "default/example",
+				"metadata.#":           "1",
+				"metadata.0.name":      "example",
+				"metadata.0.namespace": "default",
+			},
+		}
+		raw, err := config.NewRawConfig(map[string]interface{}{
+			"metadata": []interface{}{tc.Metadata},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = r.Diff(state, terraform.NewResourceConfig(raw), kp)
+		if err != nil {
+			t.Fatalf("Expected missing permissions to be reported on apply, given %s", err)
+		}
+	}
+
+	expected := `Missing permissions for the planned changes:
+
+  - delete configmaps "example" in namespace "default" (kubernetes_config_map)
+  - delete secrets "example" in namespace "default" (kubernetes_secret)`
+	create := Provider().(*schema.Provider).ResourcesMap["kubernetes_config_map"].Create
+	err = create(nil, kp)
+	if err == nil || err.Error() != expected {
+		t.Fatalf("Expected error:\n%s\n\ngiven:\n%v", expected, err)
+	}
+	err = create(nil, kp)
+	if err == nil || strings.HasPrefix(err.Error(), "Missing permissions") {
+		t.Fatalf("Expected the missing permissions to be reported once, given %v", err)
+	}
+}
+
 func TestProvider_configure(t *testing.T) {
 	resetEnv := unsetEnv(t)
 	defer resetEnv()
@@ -215,6 +428,18 @@ func skipIfNotRunningInGke(t *testing.T) {
 	}
 }
 
+func skipIfNoPodPresetsAvailable(t *testing.T) {
+	kp := testAccProvider.Meta().(*kubernetesProvider)
+	supported, err := kp.serverSupportsResourceAPIVersion("podpresets", settingsV1alpha1.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !supported {
+		t.Skip("The Kubernetes endpoint must serve the " + settingsV1alpha1.String() +
+			" API for this test to run - skipping")
+	}
+}
+
 func isRunningInMinikube() (bool, error) {
 	node, err := getFirstNode()
 	if err != nil {
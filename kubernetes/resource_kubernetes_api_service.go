@@ -0,0 +1,272 @@
+package kubernetes
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"k8s.io/apimachinery/pkg/api/errors"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	restclient "k8s.io/client-go/rest"
+)
+
+const apiServicesAPIPath = "/apis/apiregistration.k8s.io/v1beta1"
+
+func resourceKubernetesAPIService() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKubernetesAPIServiceCreate,
+		Read:   resourceKubernetesAPIServiceRead,
+		Exists: resourceKubernetesAPIServiceExists,
+		Update: resourceKubernetesAPIServiceUpdate,
+		Delete: resourceKubernetesAPIServiceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": metadataSchema("API service", false),
+			"spec": {
+				Type:        schema.TypeList,
+				Description: "Spec contains information for locating and communicating with a server. More info: https://kubernetes.io/docs/concepts/extend-kubernetes/api-extension/apiserver-aggregation/",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service": {
+							Type:        schema.TypeList,
+							Description: "Service serving the API. If not set, the API is handled locally by the API server.",
+							Optional:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"namespace": {
+										Type:        schema.TypeString,
+										Description: "Namespace of the service.",
+										Required:    true,
+									},
+									"name": {
+										Type:        schema.TypeString,
+										Description: "Name of the service.",
+										Required:    true,
+									},
+								},
+							},
+						},
+						"group": {
+							Type:        schema.TypeString,
+							Description: "API group served, e.g. `metrics.k8s.io`.",
+							Required:    true,
+							ForceNew:    true,
+						},
+						"version": {
+							Type:        schema.TypeString,
+							Description: "API version served, e.g. `v1beta1`.",
+							Required:    true,
+							ForceNew:    true,
+						},
+						"insecure_skip_tls_verify": {
+							Type:        schema.TypeBool,
+							Description: "Disables TLS certificate verification when communicating with the service. Strongly discouraged, use `ca_bundle` instead.",
+							Optional:    true,
+							Default:     false,
+						},
+						"ca_bundle": {
+							Type:        schema.TypeString,
+							Description: "PEM-encoded CA bundle used to validate the serving certificate of the service.",
+							Optional:    true,
+						},
+						"group_priority_minimum": {
+							Type:         schema.TypeInt,
+							Description:  "Minimum priority of the group. The highest priority of all versions of a group is used to order groups in discovery, e.g. for `kubectl`.",
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 20000),
+						},
+						"version_priority": {
+							Type:         schema.TypeInt,
+							Description:  "Priority of the version within its group. Higher priorities are preferred by clients.",
+							Required:     true,
+							ValidateFunc: validatePositiveInteger,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceKubernetesAPIServiceCreate(d *schema.ResourceData, meta interface{}) error {
+	kp := meta.(*kubernetesProvider)
+
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), kp.metadataSettings)
+	svc := apiService{
+		ObjectMeta: metadata,
+		Spec:       expandAPIServiceSpec(d.Get("spec").([]interface{})),
+	}
+	svc.APIVersion = "apiregistration.k8s.io/v1beta1"
+	svc.Kind = "APIService"
+	if svc.Name == "" {
+		svc.Name = svc.Spec.Version + "." + svc.Spec.Group
+	}
+
+	body, err := json.Marshal(svc)
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] Creating new API service: %#v", svc)
+	out, err := decodeAPIService(apiServicesRequest(kp.conn.CoreV1().RESTClient().Post()).
+		Body(body).
+		DoRaw())
+	if err != nil {
+		return fmt.Errorf("Failed to create API service: %s", err)
+	}
+	log.Printf("[INFO] Submitted new API service: %#v", out)
+	d.SetId(out.Name)
+
+	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		svc, err := readAPIService(kp.conn, d.Id())
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		for _, c := range svc.Status.Conditions {
+			if c.Type != "Available" {
+				continue
+			}
+			if c.Status == "True" {
+				return nil
+			}
+			return resource.RetryableError(fmt.Errorf("API service %s is not available (%s): %s", svc.Name, c.Reason, c.Message))
+		}
+		return resource.RetryableError(fmt.Errorf("Waiting for API service %s to become available", svc.Name))
+	})
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] API service %s available", d.Id())
+
+	// Let resources depending on the aggregated API discover it
+	kp.discoClient.Invalidate()
+
+	return resourceKubernetesAPIServiceRead(d, meta)
+}
+
+func resourceKubernetesAPIServiceRead(d *schema.ResourceData, meta interface{}) error {
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
+
+	name := d.Id()
+	log.Printf("[INFO] Reading API service %s", name)
+	svc, err := readAPIService(conn, name)
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return err
+	}
+	log.Printf("[INFO] Received API service: %#v", svc)
+
+	err = d.Set("metadata", flattenMetadata(svc.ObjectMeta, d, kp.metadataSettings))
+	if err != nil {
+		return err
+	}
+	err = d.Set("spec", flattenAPIServiceSpec(svc.Spec))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceKubernetesAPIServiceUpdate(d *schema.ResourceData, meta interface{}) error {
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
+
+	name := d.Id()
+	ops := patchMetadata("metadata.0.", "/metadata/", d, kp)
+	if d.HasChange("spec") {
+		ops = append(ops, &ReplaceOperation{
+			Path:  "/spec",
+			Value: expandAPIServiceSpec(d.Get("spec").([]interface{})),
+		})
+	}
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("Failed to marshal update operations: %s", err)
+	}
+	log.Printf("[INFO] Updating API service %q: %v", name, string(data))
+	out, err := decodeAPIService(apiServicesRequest(conn.CoreV1().RESTClient().Patch(pkgApi.JSONPatchType)).
+		Name(name).
+		Body(data).
+		DoRaw())
+	if err != nil {
+		return fmt.Errorf("Failed to update API service: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated API service: %#v", out)
+
+	return resourceKubernetesAPIServiceRead(d, meta)
+}
+
+func resourceKubernetesAPIServiceDelete(d *schema.ResourceData, meta interface{}) error {
+	kp := meta.(*kubernetesProvider)
+
+	name := d.Id()
+	log.Printf("[INFO] Deleting API service: %#v", name)
+	_, err := apiServicesRequest(kp.conn.CoreV1().RESTClient().Delete()).
+		Name(name).
+		Body(expandDeleteOptions(d.Get("delete_options").([]interface{}), "")).
+		DoRaw()
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] API service %s deleted", name)
+
+	kp.discoClient.Invalidate()
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKubernetesAPIServiceExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	conn := meta.(*kubernetesProvider).conn
+
+	name := d.Id()
+	log.Printf("[INFO] Checking API service %s", name)
+	_, err := readAPIService(conn, name)
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+	}
+	return true, err
+}
+
+// apiServicesRequest points a request of the core REST client
+// at the API services, as no client for them is vendored.
+func apiServicesRequest(req *restclient.Request) *restclient.Request {
+	return req.AbsPath(apiServicesAPIPath).Resource("apiservices")
+}
+
+func readAPIService(conn *kubernetes.Clientset, name string) (*apiService, error) {
+	return decodeAPIService(apiServicesRequest(conn.CoreV1().RESTClient().Get()).
+		Name(name).
+		DoRaw())
+}
+
+func decodeAPIService(raw []byte, err error) (*apiService, error) {
+	if err != nil {
+		return nil, err
+	}
+	svc := &apiService{}
+	err = json.Unmarshal(raw, svc)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to decode API service: %s", err)
+	}
+	return svc, nil
+}
@@ -0,0 +1,145 @@
+package kubernetes
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccKubernetesAPIService_basic(t *testing.T) {
+	var conf apiService
+	group := strings.ToLower(fmt.Sprintf("tf-acc-test-%s.terraform.io", acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)))
+	name := "v1." + group
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "kubernetes_api_service.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesAPIServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesAPIServiceConfig_basic(group),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesAPIServiceExists("kubernetes_api_service.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_api_service.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttrSet("kubernetes_api_service.test", "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet("kubernetes_api_service.test", "metadata.0.uid"),
+					resource.TestCheckResourceAttr("kubernetes_api_service.test", "spec.0.group", group),
+					resource.TestCheckResourceAttr("kubernetes_api_service.test", "spec.0.version", "v1"),
+					resource.TestCheckResourceAttr("kubernetes_api_service.test", "spec.0.group_priority_minimum", "100"),
+					resource.TestCheckResourceAttr("kubernetes_api_service.test", "spec.0.version_priority", "10"),
+					resource.TestCheckResourceAttr("kubernetes_api_service.test", "spec.0.service.#", "0"),
+				),
+			},
+			{
+				Config: testAccKubernetesAPIServiceConfig_modified(group),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesAPIServiceExists("kubernetes_api_service.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_api_service.test", "metadata.0.labels.%", "1"),
+					resource.TestCheckResourceAttr("kubernetes_api_service.test", "metadata.0.labels.TestLabelOne", "one"),
+					resource.TestCheckResourceAttr("kubernetes_api_service.test", "spec.0.group_priority_minimum", "200"),
+					resource.TestCheckResourceAttr("kubernetes_api_service.test", "spec.0.version_priority", "20"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesAPIService_importBasic(t *testing.T) {
+	resourceName := "kubernetes_api_service.test"
+	group := strings.ToLower(fmt.Sprintf("tf-acc-test-%s.terraform.io", acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesAPIServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesAPIServiceConfig_basic(group),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesAPIServiceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*kubernetesProvider).conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_api_service" {
+			continue
+		}
+		resp, err := readAPIService(conn, rs.Primary.ID)
+		if err == nil {
+			if resp.Name == rs.Primary.ID {
+				return fmt.Errorf("API service still exists: %s", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesAPIServiceExists(n string, obj *apiService) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*kubernetesProvider).conn
+		out, err := readAPIService(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*obj = *out
+		return nil
+	}
+}
+
+// Without a service the API is served locally, which makes the
+// API service available right away.
+func testAccKubernetesAPIServiceConfig_basic(group string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_api_service" "test" {
+	metadata {
+		name = "v1.%s"
+	}
+	spec {
+		group                  = "%s"
+		version                = "v1"
+		group_priority_minimum = 100
+		version_priority       = 10
+	}
+}
+`, group, group)
+}
+
+func testAccKubernetesAPIServiceConfig_modified(group string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_api_service" "test" {
+	metadata {
+		name = "v1.%s"
+		labels {
+			TestLabelOne = "one"
+		}
+	}
+	spec {
+		group                  = "%s"
+		version                = "v1"
+		group_priority_minimum = 200
+		version_priority       = 20
+	}
+}
+`, group, group)
+}
@@ -0,0 +1,217 @@
+package kubernetes
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	api "k8s.io/api/certificates/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+)
+
+func resourceKubernetesCertificateSigningRequest() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKubernetesCertificateSigningRequestCreate,
+		Read:   resourceKubernetesCertificateSigningRequestRead,
+		Exists: resourceKubernetesCertificateSigningRequestExists,
+		Update: resourceKubernetesCertificateSigningRequestUpdate,
+		Delete: resourceKubernetesCertificateSigningRequestDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": metadataSchema("certificate signing request", true),
+			"spec": {
+				Type:        schema.TypeList,
+				Description: "Spec of the certificate signing request. Certificate signing requests are immutable, so any change forces a new resource.",
+				Required:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"request": {
+							Type:        schema.TypeString,
+							Description: "PEM-encoded PKCS#10 certificate signing request.",
+							Required:    true,
+							ForceNew:    true,
+						},
+						"usages": {
+							Type:        schema.TypeSet,
+							Description: "Key usages requested in the issued certificate, e.g. `digital signature`, `key encipherment` and `client auth`. Defaults to `digital signature` and `key encipherment`.",
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validateAttributeValueIsIn([]string{
+									"signing", "digital signature", "content committment", "key encipherment",
+									"key agreement", "data encipherment", "cert sign", "crl sign",
+									"encipher only", "decipher only", "any", "server auth", "client auth",
+									"code signing", "email protection", "s/mime", "ipsec end system",
+									"ipsec tunnel", "ipsec user", "timestamping", "ocsp signing",
+									"microsoft sgc", "netscape sgc",
+								}),
+							},
+							Set: schema.HashString,
+						},
+					},
+				},
+			},
+			"auto_approve": {
+				Type:        schema.TypeBool,
+				Description: "Approve the certificate signing request right after submitting it. Requires permission to update the `certificatesigningrequests/approval` subresource.",
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+			},
+			"certificate": {
+				Type:        schema.TypeString,
+				Description: "PEM-encoded certificate issued for the request.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceKubernetesCertificateSigningRequestCreate(d *schema.ResourceData, meta interface{}) error {
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
+
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), kp.metadataSettings)
+	csr := api.CertificateSigningRequest{
+		ObjectMeta: metadata,
+		Spec:       expandCertificateSigningRequestSpec(d.Get("spec").([]interface{})),
+	}
+
+	log.Printf("[INFO] Creating new certificate signing request: %#v", csr)
+	out, err := conn.CertificatesV1beta1().CertificateSigningRequests().Create(&csr)
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] Submitted new certificate signing request: %#v", out)
+	d.SetId(out.Name)
+
+	if d.Get("auto_approve").(bool) {
+		out.Status.Conditions = append(out.Status.Conditions, api.CertificateSigningRequestCondition{
+			Type:    api.CertificateApproved,
+			Reason:  "TerraformAutoApprove",
+			Message: "This certificate signing request was approved by Terraform.",
+		})
+		log.Printf("[INFO] Approving certificate signing request %s", out.Name)
+		out, err = conn.CertificatesV1beta1().CertificateSigningRequests().UpdateApproval(out)
+		if err != nil {
+			return fmt.Errorf("Failed to approve certificate signing request: %s", err)
+		}
+	}
+
+	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		out, err := conn.CertificatesV1beta1().CertificateSigningRequests().Get(d.Id(), metav1.GetOptions{})
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		for _, c := range out.Status.Conditions {
+			if c.Type == api.CertificateDenied {
+				return resource.NonRetryableError(fmt.Errorf("Certificate signing request %s was denied: %s %s", out.Name, c.Reason, c.Message))
+			}
+		}
+		if len(out.Status.Certificate) > 0 {
+			return nil
+		}
+		return resource.RetryableError(fmt.Errorf("Waiting for certificate signing request %s to be approved and signed", out.Name))
+	})
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] Certificate signing request %s signed", d.Id())
+
+	return resourceKubernetesCertificateSigningRequestRead(d, meta)
+}
+
+func resourceKubernetesCertificateSigningRequestRead(d *schema.ResourceData, meta interface{}) error {
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
+
+	name := d.Id()
+	log.Printf("[INFO] Reading certificate signing request %s", name)
+	csr, err := conn.CertificatesV1beta1().CertificateSigningRequests().Get(name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) && d.Get("certificate").(string) != "" {
+			// The cluster garbage collects signed requests,
+			// while the certificate stays valid.
+			log.Printf("[INFO] Certificate signing request %s is gone, keeping its issued certificate", name)
+			return nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return err
+	}
+	log.Printf("[INFO] Received certificate signing request: %#v", csr)
+	err = d.Set("metadata", flattenMetadata(csr.ObjectMeta, d, kp.metadataSettings))
+	if err != nil {
+		return err
+	}
+	err = d.Set("spec", flattenCertificateSigningRequestSpec(csr.Spec))
+	if err != nil {
+		return err
+	}
+	d.Set("certificate", string(csr.Status.Certificate))
+
+	return nil
+}
+
+func resourceKubernetesCertificateSigningRequestUpdate(d *schema.ResourceData, meta interface{}) error {
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
+
+	name := d.Id()
+	ops := patchMetadata("metadata.0.", "/metadata/", d, kp)
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("Failed to marshal update operations: %s", err)
+	}
+	log.Printf("[INFO] Updating certificate signing request %q: %v", name, string(data))
+	out, err := conn.CertificatesV1beta1().CertificateSigningRequests().Patch(name, pkgApi.JSONPatchType, data)
+	if err != nil {
+		return fmt.Errorf("Failed to update certificate signing request: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated certificate signing request: %#v", out)
+
+	return resourceKubernetesCertificateSigningRequestRead(d, meta)
+}
+
+func resourceKubernetesCertificateSigningRequestDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubernetesProvider).conn
+
+	name := d.Id()
+	log.Printf("[INFO] Deleting certificate signing request: %#v", name)
+	err := conn.CertificatesV1beta1().CertificateSigningRequests().Delete(name, expandDeleteOptions(d.Get("delete_options").([]interface{}), ""))
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+
+	log.Printf("[INFO] Certificate signing request %s deleted", name)
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKubernetesCertificateSigningRequestExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	conn := meta.(*kubernetesProvider).conn
+
+	name := d.Id()
+	log.Printf("[INFO] Checking certificate signing request %s", name)
+	_, err := conn.CertificatesV1beta1().CertificateSigningRequests().Get(name, metav1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+			// Keep signed requests garbage collected by the cluster
+			return d.Get("certificate").(string) != "", nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+	}
+	return true, err
+}
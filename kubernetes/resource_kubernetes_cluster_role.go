@@ -69,10 +69,11 @@ func resourceKubernetesClusterRole() *schema.Resource {
 }
 
 func resourceKubernetesClusterRoleCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetesProvider).conn
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
 
 	clusterRole := rbacv1.ClusterRole{
-		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{}), kp.metadataSettings),
 		Rules:      expandRules(d.Get("rule").([]interface{})),
 	}
 	log.Printf("[INFO] Creating new cluster role map: %#v", clusterRole)
@@ -87,7 +88,8 @@ func resourceKubernetesClusterRoleCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceKubernetesClusterRoleRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetesProvider).conn
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
 
 	_, name, err := idParts(d.Id())
 	if err != nil {
@@ -101,7 +103,7 @@ func resourceKubernetesClusterRoleRead(d *schema.ResourceData, meta interface{})
 		return err
 	}
 	log.Printf("[INFO] Received cluster role: %#v", crb)
-	err = d.Set("metadata", flattenMetadata(crb.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(crb.ObjectMeta, d, kp.metadataSettings))
 	if err != nil {
 		return err
 	}
@@ -134,14 +136,15 @@ func resourceKubernetesClusterRoleExists(d *schema.ResourceData, meta interface{
 }
 
 func resourceKubernetesClusterRoleUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetesProvider).conn
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
 
 	_, name, err := idParts(d.Id())
 	if err != nil {
 		return err
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, kp)
 
 	//if d.HasChange("rule") {
 	//	return fmt.Errorf("Failed to update cluster role: cannot change role ref")
@@ -178,7 +181,7 @@ func resourceKubernetesClusterRoleDelete(d *schema.ResourceData, meta interface{
 		return err
 	}
 	log.Printf("[INFO] Deleting cluster role: %#v", name)
-	err = conn.RbacV1().ClusterRoles().Delete(name, &metav1.DeleteOptions{})
+	err = conn.RbacV1().ClusterRoles().Delete(name, expandDeleteOptions(d.Get("delete_options").([]interface{}), ""))
 	if err != nil {
 		return err
 	}
@@ -84,10 +84,11 @@ func resourceKubernetesClusterRoleBinding() *schema.Resource {
 }
 
 func resourceKubernetesClusterRoleBindingCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetesProvider).conn
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
 
 	clusterRoleBinding := rbacv1.ClusterRoleBinding{
-		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{}), kp.metadataSettings),
 		RoleRef:    expandRoleRef(d.Get("role_ref").(map[string]interface{})),
 		Subjects:   expandSubjects(d.Get("subject").([]interface{})),
 	}
@@ -103,7 +104,8 @@ func resourceKubernetesClusterRoleBindingCreate(d *schema.ResourceData, meta int
 }
 
 func resourceKubernetesClusterRoleBindingRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetesProvider).conn
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
 
 	_, name, err := idParts(d.Id())
 	if err != nil {
@@ -117,7 +119,7 @@ func resourceKubernetesClusterRoleBindingRead(d *schema.ResourceData, meta inter
 		return err
 	}
 	log.Printf("[INFO] Received cluster role binding: %#v", crb)
-	err = d.Set("metadata", flattenMetadata(crb.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(crb.ObjectMeta, d, kp.metadataSettings))
 	if err != nil {
 		return err
 	}
@@ -155,14 +157,15 @@ func resourceKubernetesClusterRoleBindingExists(d *schema.ResourceData, meta int
 }
 
 func resourceKubernetesClusterRoleBindingUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetesProvider).conn
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
 
 	_, name, err := idParts(d.Id())
 	if err != nil {
 		return err
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, kp)
 
 	if d.HasChange("role_ref") {
 		return fmt.Errorf("Failed to update cluster role binding: cannot change role ref")
@@ -199,7 +202,7 @@ func resourceKubernetesClusterRoleBindingDelete(d *schema.ResourceData, meta int
 		return err
 	}
 	log.Printf("[INFO] Deleting cluster role binding: %#v", name)
-	err = conn.RbacV1().ClusterRoleBindings().Delete(name, &metav1.DeleteOptions{})
+	err = conn.RbacV1().ClusterRoleBindings().Delete(name, expandDeleteOptions(d.Get("delete_options").([]interface{}), ""))
 	if err != nil {
 		return err
 	}
@@ -1,6 +1,7 @@
 package kubernetes
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 
@@ -9,15 +10,17 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	pkgApi "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 )
 
 func resourceKubernetesConfigMap() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceKubernetesConfigMapCreate,
-		Read:   resourceKubernetesConfigMapRead,
-		Exists: resourceKubernetesConfigMapExists,
-		Update: resourceKubernetesConfigMapUpdate,
-		Delete: resourceKubernetesConfigMapDelete,
+		Create:        resourceKubernetesConfigMapCreate,
+		Read:          resourceKubernetesConfigMapRead,
+		Exists:        resourceKubernetesConfigMapExists,
+		Update:        resourceKubernetesConfigMapUpdate,
+		Delete:        resourceKubernetesConfigMapDelete,
+		CustomizeDiff: customizeDiffBinaryDataOverlap,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -29,31 +32,65 @@ func resourceKubernetesConfigMap() *schema.Resource {
 				Description: "A map of the configuration data.",
 				Optional:    true,
 			},
+			"binary_data": {
+				Type:             schema.TypeMap,
+				Description:      "A map of base64 encoded binary configuration data. Keys must not overlap with the keys in `data`.",
+				Optional:         true,
+				ValidateFunc:     validateBase64EncodedMap,
+				DiffSuppressFunc: suppressEquivalentBase64,
+			},
 		},
 	}
 }
 
 func resourceKubernetesConfigMapCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetesProvider).conn
-
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	cfgMap := api.ConfigMap{
-		ObjectMeta: metadata,
-		Data:       expandStringMap(d.Get("data").(map[string]interface{})),
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
+
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), kp.metadataSettings)
+	cfgMap := configMapWithBinaryData{
+		ConfigMap: api.ConfigMap{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "v1",
+				Kind:       "ConfigMap",
+			},
+			ObjectMeta: metadata,
+			Data:       expandStringMap(d.Get("data").(map[string]interface{})),
+		},
+		BinaryData: expandBase64MapToByteMap(d.Get("binary_data").(map[string]interface{})),
+	}
+	body, err := json.Marshal(cfgMap)
+	if err != nil {
+		return fmt.Errorf("Failed to marshal config map: %s", err)
 	}
 	log.Printf("[INFO] Creating new config map: %#v", cfgMap)
-	out, err := conn.CoreV1().ConfigMaps(metadata.Namespace).Create(&cfgMap)
+	raw, err := conn.CoreV1().RESTClient().Post().
+		Namespace(metadata.Namespace).
+		Resource("configmaps").
+		Body(body).
+		DoRaw()
 	if err != nil {
 		return err
 	}
+	var out api.ConfigMap
+	err = json.Unmarshal(raw, &out)
+	if err != nil {
+		return fmt.Errorf("Failed to decode created config map: %s", err)
+	}
 	log.Printf("[INFO] Submitted new config map: %#v", out)
 	d.SetId(buildId(out.ObjectMeta))
 
+	err = rolloutConfigConsumers(kp, out.Namespace, out.Name, "")
+	if err != nil {
+		return fmt.Errorf("Failed to roll out workloads referring to config map %q: %s", out.Name, err)
+	}
+
 	return resourceKubernetesConfigMapRead(d, meta)
 }
 
 func resourceKubernetesConfigMapRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetesProvider).conn
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -66,29 +103,43 @@ func resourceKubernetesConfigMapRead(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 	log.Printf("[INFO] Received config map: %#v", cfgMap)
-	err = d.Set("metadata", flattenMetadata(cfgMap.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(cfgMap.ObjectMeta, d, kp.metadataSettings))
 	if err != nil {
 		return err
 	}
 	d.Set("data", cfgMap.Data)
 
+	binaryData, err := getConfigMapBinaryData(conn, namespace, name)
+	if err != nil {
+		return err
+	}
+	d.Set("binary_data", base64EncodeByteMap(binaryData))
+
 	return nil
 }
 
 func resourceKubernetesConfigMapUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetesProvider).conn
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return err
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, kp)
 	if d.HasChange("data") {
 		oldV, newV := d.GetChange("data")
 		diffOps := diffStringMap("/data/", oldV.(map[string]interface{}), newV.(map[string]interface{}))
 		ops = append(ops, diffOps...)
 	}
+	if d.HasChange("binary_data") {
+		oldV, newV := d.GetChange("binary_data")
+		oldV = base64NormalizeStringMap(oldV.(map[string]interface{}))
+		newV = base64NormalizeStringMap(newV.(map[string]interface{}))
+		diffOps := diffStringMap("/binaryData/", oldV.(map[string]interface{}), newV.(map[string]interface{}))
+		ops = append(ops, diffOps...)
+	}
 	data, err := ops.MarshalJSON()
 	if err != nil {
 		return fmt.Errorf("Failed to marshal update operations: %s", err)
@@ -101,6 +152,13 @@ func resourceKubernetesConfigMapUpdate(d *schema.ResourceData, meta interface{})
 	log.Printf("[INFO] Submitted updated config map: %#v", out)
 	d.SetId(buildId(out.ObjectMeta))
 
+	if d.HasChange("data") || d.HasChange("binary_data") {
+		err = rolloutConfigConsumers(kp, namespace, name, "")
+		if err != nil {
+			return fmt.Errorf("Failed to roll out workloads referring to config map %q: %s", name, err)
+		}
+	}
+
 	return resourceKubernetesConfigMapRead(d, meta)
 }
 
@@ -112,7 +170,7 @@ func resourceKubernetesConfigMapDelete(d *schema.ResourceData, meta interface{})
 		return err
 	}
 	log.Printf("[INFO] Deleting config map: %#v", name)
-	err = conn.CoreV1().ConfigMaps(namespace).Delete(name, &metav1.DeleteOptions{})
+	err = conn.CoreV1().ConfigMaps(namespace).Delete(name, expandDeleteOptions(d.Get("delete_options").([]interface{}), ""))
 	if err != nil {
 		return err
 	}
@@ -141,3 +199,32 @@ func resourceKubernetesConfigMapExists(d *schema.ResourceData, meta interface{})
 	}
 	return true, err
 }
+
+// configMapWithBinaryData adds the binaryData field to the vendored
+// ConfigMap type, which predates it.
+type configMapWithBinaryData struct {
+	api.ConfigMap
+	BinaryData map[string][]byte `json:"binaryData,omitempty"`
+}
+
+// getConfigMapBinaryData fetches the raw config map, because the vendored
+// ConfigMap type predates the binaryData field and would drop it.
+func getConfigMapBinaryData(conn *kubernetes.Clientset, namespace, name string) (map[string][]byte, error) {
+	raw, err := conn.CoreV1().RESTClient().Get().
+		Namespace(namespace).
+		Resource("configmaps").
+		Name(name).
+		DoRaw()
+	if err != nil {
+		return nil, err
+	}
+
+	var cfgMap struct {
+		BinaryData map[string][]byte `json:"binaryData,omitempty"`
+	}
+	err = json.Unmarshal(raw, &cfgMap)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to decode binary data of config map %q: %s", name, err)
+	}
+	return cfgMap.BinaryData, nil
+}
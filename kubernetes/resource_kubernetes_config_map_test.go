@@ -188,6 +188,213 @@ func TestAccKubernetesConfigMap_importGeneratedName(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesConfigMap_binaryData(t *testing.T) {
+	var conf api.ConfigMap
+	prefix := "tf-acc-test-gen-"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "kubernetes_config_map.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesConfigMapDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesConfigMapConfig_binaryData(prefix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesConfigMapExists("kubernetes_config_map.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_config_map.test", "data.%", "1"),
+					resource.TestCheckResourceAttr("kubernetes_config_map.test", "data.text", "plain"),
+					resource.TestCheckResourceAttr("kubernetes_config_map.test", "binary_data.%", "1"),
+				),
+			},
+			{
+				Config: testAccKubernetesConfigMapConfig_binaryData2(prefix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesConfigMapExists("kubernetes_config_map.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_config_map.test", "data.%", "0"),
+					resource.TestCheckResourceAttr("kubernetes_config_map.test", "binary_data.%", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesConfigMap_binaryDataOverlap(t *testing.T) {
+	prefix := "tf-acc-test-gen-"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesConfigMapDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubernetesConfigMapConfig_binaryDataOverlap(prefix),
+				ExpectError: regexp.MustCompile("binary_data.one: key is also set in data"),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesConfigMap_ownerReferencesAndFinalizers(t *testing.T) {
+	var parent, child api.ConfigMap
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "kubernetes_config_map.child",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesConfigMapDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesConfigMapConfig_ownerReferences(name, `finalizers = ["example.com/test"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesConfigMapExists("kubernetes_config_map.parent", &parent),
+					testAccCheckKubernetesConfigMapExists("kubernetes_config_map.child", &child),
+					resource.TestCheckResourceAttr("kubernetes_config_map.child", "metadata.0.owner_references.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_config_map.child", "metadata.0.owner_references.0.api_version", "v1"),
+					resource.TestCheckResourceAttr("kubernetes_config_map.child", "metadata.0.owner_references.0.kind", "ConfigMap"),
+					resource.TestCheckResourceAttr("kubernetes_config_map.child", "metadata.0.owner_references.0.name", name),
+					resource.TestCheckResourceAttrPair("kubernetes_config_map.child", "metadata.0.owner_references.0.uid",
+						"kubernetes_config_map.parent", "metadata.0.uid"),
+					resource.TestCheckResourceAttr("kubernetes_config_map.child", "metadata.0.owner_references.0.block_owner_deletion", "true"),
+					resource.TestCheckResourceAttr("kubernetes_config_map.child", "metadata.0.owner_references.0.controller", "false"),
+					resource.TestCheckResourceAttr("kubernetes_config_map.child", "metadata.0.finalizers.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_config_map.child", "metadata.0.finalizers.0", "example.com/test"),
+					testAccCheckConfigMapFinalizers(&child, []string{"example.com/test"}),
+				),
+			},
+			{
+				// The finalizer has to go for the config map to be deleted
+				Config: testAccKubernetesConfigMapConfig_ownerReferences(name, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesConfigMapExists("kubernetes_config_map.child", &child),
+					resource.TestCheckResourceAttr("kubernetes_config_map.child", "metadata.0.owner_references.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_config_map.child", "metadata.0.finalizers.#", "0"),
+					testAccCheckConfigMapFinalizers(&child, nil),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesConfigMap_defaultMetadata(t *testing.T) {
+	var conf api.ConfigMap
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "kubernetes_config_map.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesConfigMapDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesConfigMapConfig_defaultMetadata(name, "first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesConfigMapExists("kubernetes_config_map.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_config_map.test", "metadata.0.annotations.%", "0"),
+					testAccCheckMetaAnnotations(&conf.ObjectMeta, map[string]string{"example.com/owner": "infra"}),
+					resource.TestCheckResourceAttr("kubernetes_config_map.test", "metadata.0.labels.%", "2"),
+					resource.TestCheckResourceAttr("kubernetes_config_map.test", "metadata.0.labels.team", "storage"),
+					resource.TestCheckResourceAttr("kubernetes_config_map.test", "metadata.0.labels.TestLabel", "first"),
+					testAccCheckMetaLabels(&conf.ObjectMeta, map[string]string{
+						"team":       "storage",
+						"managed-by": "terraform",
+						"TestLabel":  "first",
+					}),
+				),
+			},
+			{
+				Config: testAccKubernetesConfigMapConfig_defaultMetadata(name, "second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesConfigMapExists("kubernetes_config_map.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_config_map.test", "metadata.0.labels.%", "2"),
+					resource.TestCheckResourceAttr("kubernetes_config_map.test", "metadata.0.labels.TestLabel", "second"),
+					testAccCheckMetaLabels(&conf.ObjectMeta, map[string]string{
+						"team":       "storage",
+						"managed-by": "terraform",
+						"TestLabel":  "second",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesConfigMap_providerNamespace(t *testing.T) {
+	var conf api.ConfigMap
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "kubernetes_config_map.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesConfigMapDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesConfigMapConfig_providerNamespace("kube-public", name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesConfigMapExists("kubernetes_config_map.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_config_map.test", "id", "kube-public/"+name),
+					resource.TestCheckResourceAttr("kubernetes_config_map.test", "metadata.0.namespace", "kube-public"),
+				),
+			},
+			{
+				ResourceName:      "kubernetes_config_map.test",
+				Config:            testAccKubernetesConfigMapConfig_providerNamespace("kube-public", name),
+				ImportState:       true,
+				ImportStateId:     name,
+				ImportStateVerify: true,
+			},
+			{
+				// Existing objects stay in their namespace
+				Config: testAccKubernetesConfigMapConfig_providerNamespace("default", name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesConfigMapExists("kubernetes_config_map.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_config_map.test", "id", "kube-public/"+name),
+					resource.TestCheckResourceAttr("kubernetes_config_map.test", "metadata.0.namespace", "kube-public"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesConfigMap_retainOnDestroy(t *testing.T) {
+	var conf api.ConfigMap
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "kubernetes_config_map.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesConfigMapRetained(name),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesConfigMapConfig_retainOnDestroy(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesConfigMapExists("kubernetes_config_map.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_config_map.test", "retain_on_destroy", "true"),
+					resource.TestCheckResourceAttr("kubernetes_config_map.test", "delete_options.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_config_map.test", "delete_options.0.propagation_policy", "Background"),
+					resource.TestCheckResourceAttr("kubernetes_config_map.test", "delete_options.0.grace_period_seconds", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckConfigMapFinalizers(m *api.ConfigMap, expected []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(expected) == 0 && len(m.Finalizers) == 0 {
+			return nil
+		}
+		if !reflect.DeepEqual(m.Finalizers, expected) {
+			return fmt.Errorf("%s finalizers don't match.\nExpected: %q\nGiven: %q",
+				m.Name, expected, m.Finalizers)
+		}
+		return nil
+	}
+}
+
 func testAccCheckConfigMapData(m *api.ConfigMap, expected map[string]string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if len(expected) == 0 && len(m.Data) == 0 {
@@ -223,6 +430,20 @@ func testAccCheckKubernetesConfigMapDestroy(s *terraform.State) error {
 	return nil
 }
 
+// testAccCheckKubernetesConfigMapRetained checks the config map outlived
+// the destruction of its resource and cleans it up.
+func testAccCheckKubernetesConfigMapRetained(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*kubernetesProvider).conn
+
+		_, err := conn.CoreV1().ConfigMaps("default").Get(name, meta_v1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("Config Map was not retained: %s", err)
+		}
+		return conn.CoreV1().ConfigMaps("default").Delete(name, &meta_v1.DeleteOptions{})
+	}
+}
+
 func testAccCheckKubernetesConfigMapExists(n string, obj *api.ConfigMap) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -329,3 +550,131 @@ resource "kubernetes_config_map" "test" {
 	}
 }`, prefix)
 }
+
+func testAccKubernetesConfigMapConfig_binaryData(prefix string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_config_map" "test" {
+	metadata {
+		generate_name = "%s"
+	}
+	data {
+		text = "plain"
+	}
+	binary_data {
+		one = "${base64encode(file("./test-fixtures/binary.data"))}"
+	}
+}`, prefix)
+}
+
+func testAccKubernetesConfigMapConfig_binaryData2(prefix string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_config_map" "test" {
+	metadata {
+		generate_name = "%s"
+	}
+	binary_data {
+		one = "${base64encode(file("./test-fixtures/binary2.data"))}"
+		two = "${base64encode(file("./test-fixtures/binary.data"))}"
+	}
+}`, prefix)
+}
+
+func testAccKubernetesConfigMapConfig_binaryDataOverlap(prefix string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_config_map" "test" {
+	metadata {
+		generate_name = "%s"
+	}
+	data {
+		one = "plain"
+	}
+	binary_data {
+		one = "${base64encode(file("./test-fixtures/binary.data"))}"
+	}
+}`, prefix)
+}
+
+func testAccKubernetesConfigMapConfig_ownerReferences(name, finalizers string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_config_map" "parent" {
+	metadata {
+		name = "%s"
+	}
+}
+
+resource "kubernetes_config_map" "child" {
+	metadata {
+		name = "%s-child"
+		owner_references {
+			api_version          = "v1"
+			kind                 = "ConfigMap"
+			name                 = "${kubernetes_config_map.parent.metadata.0.name}"
+			uid                  = "${kubernetes_config_map.parent.metadata.0.uid}"
+			block_owner_deletion = true
+		}
+		%s
+	}
+	data {
+		one = "first"
+	}
+}`, name, name, finalizers)
+}
+
+func testAccKubernetesConfigMapConfig_defaultMetadata(name, label string) string {
+	return fmt.Sprintf(`
+provider "kubernetes" {
+	default_labels {
+		team       = "infra"
+		managed-by = "terraform"
+	}
+	default_annotations {
+		"example.com/owner" = "infra"
+	}
+}
+
+resource "kubernetes_config_map" "test" {
+	metadata {
+		labels {
+			team      = "storage"
+			TestLabel = "%s"
+		}
+		name = "%s"
+	}
+	data {
+		one = "first"
+	}
+}`, label, name)
+}
+
+func testAccKubernetesConfigMapConfig_providerNamespace(namespace, name string) string {
+	return fmt.Sprintf(`
+provider "kubernetes" {
+	namespace = "%s"
+}
+
+resource "kubernetes_config_map" "test" {
+	metadata {
+		name = "%s"
+	}
+	data {
+		one = "first"
+	}
+}`, namespace, name)
+}
+
+func testAccKubernetesConfigMapConfig_retainOnDestroy(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_config_map" "test" {
+	metadata {
+		name = "%s"
+	}
+	data {
+		one = "first"
+	}
+	delete_options {
+		propagation_policy   = "Background"
+		grace_period_seconds = 0
+	}
+	retain_on_destroy = true
+}`, name)
+}
@@ -48,8 +48,8 @@ func resourceKubernetesCronJobCreate(d *schema.ResourceData, meta interface{}) e
 	kp := meta.(*kubernetesProvider)
 	conn := kp.conn
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	spec, err := expandCronJobSpec(d.Get("spec").([]interface{}))
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), kp.metadataSettings)
+	spec, err := expandCronJobSpec(d.Get("spec").([]interface{}), kp.metadataSettings)
 	if err != nil {
 		return err
 	}
@@ -104,12 +104,16 @@ func resourceKubernetesCronJobUpdate(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	spec, err := expandCronJobSpec(d.Get("spec").([]interface{}))
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), kp.metadataSettings)
+	spec, err := expandCronJobSpec(d.Get("spec").([]interface{}), kp.metadataSettings)
 	if err != nil {
 		return err
 	}
 	spec.JobTemplate.ObjectMeta.Annotations = metadata.Annotations
+	err = keepIgnoredMetadata(d, kp, &metadata)
+	if err != nil {
+		return err
+	}
 
 	cronjob := &v1beta1.CronJob{
 		ObjectMeta: metadata,
@@ -187,12 +191,12 @@ func resourceKubernetesCronJobRead(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
-	err = d.Set("metadata", flattenMetadata(job.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(job.ObjectMeta, d, kp.metadataSettings))
 	if err != nil {
 		return err
 	}
 
-	jobSpec, err := flattenCronJobSpec(job.Spec, d)
+	jobSpec, err := flattenCronJobSpec(job.Spec, d, kp.metadataSettings)
 	if err != nil {
 		return err
 	}
@@ -215,16 +219,17 @@ func resourceKubernetesCronJobDelete(d *schema.ResourceData, meta interface{}) e
 	}
 
 	log.Printf("[INFO] Deleting cron job: %#v", name)
+	opts := expandDeleteOptions(d.Get("delete_options").([]interface{}), "")
 	apiGroup, err := kp.highestSupportedAPIGroup(cronJobResourceGroupName, cronJobAPIGroups...)
 	if err != nil {
 		return err
 	}
 	switch apiGroup {
 	case batchV1beta1:
-		err = conn.BatchV1beta1().CronJobs(namespace).Delete(name, nil)
+		err = conn.BatchV1beta1().CronJobs(namespace).Delete(name, opts)
 
 	case batchV2alpha1:
-		err = conn.BatchV2alpha1().CronJobs(namespace).Delete(name, nil)
+		err = conn.BatchV2alpha1().CronJobs(namespace).Delete(name, opts)
 
 	default:
 		err = cronJobNotSupportedError
@@ -0,0 +1,411 @@
+package kubernetes
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"k8s.io/apimachinery/pkg/api/errors"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	restclient "k8s.io/client-go/rest"
+)
+
+const customResourceDefinitionsAPIPath = "/apis/apiextensions.k8s.io/v1beta1"
+
+func resourceKubernetesCustomResourceDefinition() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKubernetesCustomResourceDefinitionCreate,
+		Read:   resourceKubernetesCustomResourceDefinitionRead,
+		Exists: resourceKubernetesCustomResourceDefinitionExists,
+		Update: resourceKubernetesCustomResourceDefinitionUpdate,
+		Delete: resourceKubernetesCustomResourceDefinitionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": metadataSchema("custom resource definition", false),
+			"spec": {
+				Type:        schema.TypeList,
+				Description: "Spec describes how the user wants the resources to appear. More info: https://kubernetes.io/docs/tasks/access-kubernetes-api/custom-resources/custom-resource-definitions/",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group": {
+							Type:        schema.TypeString,
+							Description: "API group of the custom resources, e.g. `stable.example.com`.",
+							Required:    true,
+							ForceNew:    true,
+						},
+						"scope": {
+							Type:         schema.TypeString,
+							Description:  "Whether the custom resources are `Namespaced` or `Cluster` scoped.",
+							Optional:     true,
+							ForceNew:     true,
+							Default:      "Namespaced",
+							ValidateFunc: validateAttributeValueIsIn([]string{"Namespaced", "Cluster"}),
+						},
+						"names": {
+							Type:        schema.TypeList,
+							Description: "Names used to serve the custom resources.",
+							Required:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"plural": {
+										Type:        schema.TypeString,
+										Description: "Plural name of the resource to serve, e.g. `crontabs`. Must be all lowercase.",
+										Required:    true,
+										ForceNew:    true,
+									},
+									"singular": {
+										Type:        schema.TypeString,
+										Description: "Singular name of the resource, e.g. `crontab`. Must be all lowercase. Defaults to the lowercased `kind`.",
+										Optional:    true,
+										Computed:    true,
+									},
+									"kind": {
+										Type:        schema.TypeString,
+										Description: "Kind of the custom resources, e.g. `CronTab`.",
+										Required:    true,
+										ForceNew:    true,
+									},
+									"list_kind": {
+										Type:        schema.TypeString,
+										Description: "Kind of lists of the custom resources. Defaults to `<kind>List`.",
+										Optional:    true,
+										Computed:    true,
+									},
+									"short_names": {
+										Type:        schema.TypeList,
+										Description: "Short names for the resource, e.g. `ct` for use with `kubectl get ct`. Must be all lowercase.",
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+									},
+									"categories": {
+										Type:        schema.TypeList,
+										Description: "Grouped resources the custom resource belongs to, e.g. `all`.",
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"version": {
+							Type:        schema.TypeList,
+							Description: "Versions the custom resources are served in. The first one is used by clusters not supporting multiple versions.",
+							Required:    true,
+							MinItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Description: "Name of the version, e.g. `v1beta1`.",
+										Required:    true,
+									},
+									"served": {
+										Type:        schema.TypeBool,
+										Description: "Whether the version is served by the REST API.",
+										Optional:    true,
+										Default:     true,
+									},
+									"storage": {
+										Type:        schema.TypeBool,
+										Description: "Whether the version is used to persist the custom resources. Exactly one version must be the storage version.",
+										Optional:    true,
+										Default:     false,
+									},
+								},
+							},
+						},
+						"validation": {
+							Type:             schema.TypeString,
+							Description:      "OpenAPI v3 schema the custom resources are validated against, in JSON.",
+							Optional:         true,
+							ValidateFunc:     validateJSON,
+							DiffSuppressFunc: suppressEquivalentJSON,
+						},
+						"subresources": {
+							Type:        schema.TypeList,
+							Description: "Subresources served for the custom resources.",
+							Optional:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"status": {
+										Type:        schema.TypeBool,
+										Description: "Serve the `/status` subresource, making the main endpoint ignore changes of `.status`.",
+										Optional:    true,
+										Default:     false,
+									},
+									"scale": {
+										Type:        schema.TypeList,
+										Description: "Serve the `/scale` subresource.",
+										Optional:    true,
+										MaxItems:    1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"spec_replicas_path": {
+													Type:        schema.TypeString,
+													Description: "JSON path of the desired replicas in the custom resources, e.g. `.spec.replicas`.",
+													Required:    true,
+												},
+												"status_replicas_path": {
+													Type:        schema.TypeString,
+													Description: "JSON path of the actual replicas in the custom resources, e.g. `.status.replicas`.",
+													Required:    true,
+												},
+												"label_selector_path": {
+													Type:        schema.TypeString,
+													Description: "JSON path of the label selector of the replicas in the custom resources, e.g. `.status.labelSelector`.",
+													Optional:    true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"additional_printer_column": {
+							Type:        schema.TypeList,
+							Description: "Additional columns shown by `kubectl get`. Defaults to the age of the custom resources.",
+							Optional:    true,
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Description: "Human readable name of the column.",
+										Required:    true,
+									},
+									"type": {
+										Type:         schema.TypeString,
+										Description:  "OpenAPI type of the column, one of `integer`, `number`, `string`, `boolean` or `date`.",
+										Required:     true,
+										ValidateFunc: validateAttributeValueIsIn([]string{"integer", "number", "string", "boolean", "date"}),
+									},
+									"format": {
+										Type:        schema.TypeString,
+										Description: "Optional OpenAPI format of the column, e.g. `int32` or `date-time`.",
+										Optional:    true,
+									},
+									"description": {
+										Type:        schema.TypeString,
+										Description: "Human readable description of the column.",
+										Optional:    true,
+									},
+									"priority": {
+										Type:        schema.TypeInt,
+										Description: "Importance of the column. Columns with a priority greater than `0` are only shown in wide output.",
+										Optional:    true,
+										Default:     0,
+									},
+									"json_path": {
+										Type:        schema.TypeString,
+										Description: "JSON path evaluated against each custom resource to produce the value of the column, e.g. `.spec.schedule`.",
+										Required:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceKubernetesCustomResourceDefinitionCreate(d *schema.ResourceData, meta interface{}) error {
+	kp := meta.(*kubernetesProvider)
+
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), kp.metadataSettings)
+	crd := customResourceDefinition{
+		ObjectMeta: metadata,
+		Spec:       expandCustomResourceDefinitionSpec(d.Get("spec").([]interface{})),
+	}
+	crd.APIVersion = "apiextensions.k8s.io/v1beta1"
+	crd.Kind = "CustomResourceDefinition"
+	if crd.Name == "" {
+		crd.Name = crd.Spec.Names.Plural + "." + crd.Spec.Group
+	}
+
+	body, err := json.Marshal(crd)
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] Creating new custom resource definition: %s", body)
+	out, err := decodeCustomResourceDefinition(customResourceDefinitionsRequest(kp.conn.CoreV1().RESTClient().Post()).
+		Body(body).
+		DoRaw())
+	if err != nil {
+		return fmt.Errorf("Failed to create custom resource definition: %s", err)
+	}
+	log.Printf("[INFO] Submitted new custom resource definition: %#v", out)
+	d.SetId(out.Name)
+
+	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		crd, err := readCustomResourceDefinition(kp.conn, d.Id())
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		for _, c := range crd.Status.Conditions {
+			if c.Type == "NamesAccepted" && c.Status == "False" {
+				return resource.NonRetryableError(fmt.Errorf("Names of custom resource definition %s were not accepted: %s", crd.Name, c.Message))
+			}
+			if c.Type == "Established" && c.Status == "True" {
+				return nil
+			}
+		}
+		return resource.RetryableError(fmt.Errorf("Waiting for custom resource definition %s to be established", crd.Name))
+	})
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] Custom resource definition %s established", d.Id())
+
+	// Let resources depending on the new kind discover it
+	kp.discoClient.Invalidate()
+
+	return resourceKubernetesCustomResourceDefinitionRead(d, meta)
+}
+
+func resourceKubernetesCustomResourceDefinitionRead(d *schema.ResourceData, meta interface{}) error {
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
+
+	name := d.Id()
+	log.Printf("[INFO] Reading custom resource definition %s", name)
+	crd, err := readCustomResourceDefinition(conn, name)
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return err
+	}
+	log.Printf("[INFO] Received custom resource definition: %#v", crd)
+
+	err = d.Set("metadata", flattenMetadata(crd.ObjectMeta, d, kp.metadataSettings))
+	if err != nil {
+		return err
+	}
+	err = d.Set("spec", flattenCustomResourceDefinitionSpec(crd.Spec))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceKubernetesCustomResourceDefinitionUpdate(d *schema.ResourceData, meta interface{}) error {
+	kp := meta.(*kubernetesProvider)
+
+	name := d.Id()
+	ops := patchMetadata("metadata.0.", "/metadata/", d, kp)
+	if d.HasChange("spec") {
+		ops = append(ops, &ReplaceOperation{
+			Path:  "/spec",
+			Value: expandCustomResourceDefinitionSpec(d.Get("spec").([]interface{})),
+		})
+	}
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("Failed to marshal update operations: %s", err)
+	}
+	log.Printf("[INFO] Updating custom resource definition %q: %v", name, string(data))
+	out, err := decodeCustomResourceDefinition(customResourceDefinitionsRequest(kp.conn.CoreV1().RESTClient().Patch(pkgApi.JSONPatchType)).
+		Name(name).
+		Body(data).
+		DoRaw())
+	if err != nil {
+		return fmt.Errorf("Failed to update custom resource definition: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated custom resource definition: %#v", out)
+
+	if d.HasChange("spec") {
+		kp.discoClient.Invalidate()
+	}
+
+	return resourceKubernetesCustomResourceDefinitionRead(d, meta)
+}
+
+func resourceKubernetesCustomResourceDefinitionDelete(d *schema.ResourceData, meta interface{}) error {
+	kp := meta.(*kubernetesProvider)
+
+	name := d.Id()
+	log.Printf("[INFO] Deleting custom resource definition: %#v", name)
+	_, err := customResourceDefinitionsRequest(kp.conn.CoreV1().RESTClient().Delete()).
+		Name(name).
+		Body(expandDeleteOptions(d.Get("delete_options").([]interface{}), "")).
+		DoRaw()
+	if err != nil {
+		return err
+	}
+
+	// Deletion waits for all custom resources to be removed
+	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := readCustomResourceDefinition(kp.conn, name)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+		return resource.RetryableError(fmt.Errorf("Custom resource definition %s still exists", name))
+	})
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] Custom resource definition %s deleted", name)
+
+	kp.discoClient.Invalidate()
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKubernetesCustomResourceDefinitionExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	conn := meta.(*kubernetesProvider).conn
+
+	name := d.Id()
+	log.Printf("[INFO] Checking custom resource definition %s", name)
+	_, err := readCustomResourceDefinition(conn, name)
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+	}
+	return true, err
+}
+
+// customResourceDefinitionsRequest points a request of the core REST client
+// at the custom resource definitions, as no client for them is vendored.
+func customResourceDefinitionsRequest(req *restclient.Request) *restclient.Request {
+	return req.AbsPath(customResourceDefinitionsAPIPath).Resource("customresourcedefinitions")
+}
+
+func readCustomResourceDefinition(conn *kubernetes.Clientset, name string) (*customResourceDefinition, error) {
+	return decodeCustomResourceDefinition(customResourceDefinitionsRequest(conn.CoreV1().RESTClient().Get()).
+		Name(name).
+		DoRaw())
+}
+
+func decodeCustomResourceDefinition(raw []byte, err error) (*customResourceDefinition, error) {
+	if err != nil {
+		return nil, err
+	}
+	crd := &customResourceDefinition{}
+	err = json.Unmarshal(raw, crd)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to decode custom resource definition: %s", err)
+	}
+	return crd, nil
+}
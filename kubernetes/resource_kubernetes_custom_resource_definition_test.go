@@ -0,0 +1,192 @@
+package kubernetes
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccKubernetesCustomResourceDefinition_basic(t *testing.T) {
+	var conf customResourceDefinition
+	plural := fmt.Sprintf("tfacctest%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	plural = strings.ToLower(plural)
+	name := plural + ".terraform.io"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "kubernetes_custom_resource_definition.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesCustomResourceDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesCustomResourceDefinitionConfig_basic(plural),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesCustomResourceDefinitionExists("kubernetes_custom_resource_definition.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_custom_resource_definition.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttrSet("kubernetes_custom_resource_definition.test", "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet("kubernetes_custom_resource_definition.test", "metadata.0.uid"),
+					resource.TestCheckResourceAttr("kubernetes_custom_resource_definition.test", "spec.0.group", "terraform.io"),
+					resource.TestCheckResourceAttr("kubernetes_custom_resource_definition.test", "spec.0.scope", "Namespaced"),
+					resource.TestCheckResourceAttr("kubernetes_custom_resource_definition.test", "spec.0.names.0.plural", plural),
+					resource.TestCheckResourceAttr("kubernetes_custom_resource_definition.test", "spec.0.names.0.kind", "TestWidget"),
+					resource.TestCheckResourceAttr("kubernetes_custom_resource_definition.test", "spec.0.names.0.list_kind", "TestWidgetList"),
+					resource.TestCheckResourceAttr("kubernetes_custom_resource_definition.test", "spec.0.version.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_custom_resource_definition.test", "spec.0.version.0.name", "v1"),
+					resource.TestCheckResourceAttr("kubernetes_custom_resource_definition.test", "spec.0.version.0.served", "true"),
+					resource.TestCheckResourceAttr("kubernetes_custom_resource_definition.test", "spec.0.version.0.storage", "true"),
+					resource.TestCheckResourceAttr("kubernetes_custom_resource_definition.test", "spec.0.additional_printer_column.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_custom_resource_definition.test", "spec.0.additional_printer_column.0.name", "Age"),
+					resource.TestCheckResourceAttr("kubernetes_custom_resource_definition.test", "spec.0.additional_printer_column.0.json_path", ".metadata.creationTimestamp"),
+				),
+			},
+			{
+				Config: testAccKubernetesCustomResourceDefinitionConfig_modified(plural),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesCustomResourceDefinitionExists("kubernetes_custom_resource_definition.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_custom_resource_definition.test", "metadata.0.labels.%", "1"),
+					resource.TestCheckResourceAttr("kubernetes_custom_resource_definition.test", "metadata.0.labels.TestLabelOne", "one"),
+					resource.TestCheckResourceAttr("kubernetes_custom_resource_definition.test", "spec.0.names.0.short_names.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_custom_resource_definition.test", "spec.0.names.0.short_names.0", "tw"),
+					resource.TestCheckResourceAttrSet("kubernetes_custom_resource_definition.test", "spec.0.validation"),
+					resource.TestCheckResourceAttr("kubernetes_custom_resource_definition.test", "spec.0.subresources.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_custom_resource_definition.test", "spec.0.subresources.0.status", "true"),
+					resource.TestCheckResourceAttr("kubernetes_custom_resource_definition.test", "spec.0.subresources.0.scale.0.spec_replicas_path", ".spec.replicas"),
+					resource.TestCheckResourceAttr("kubernetes_custom_resource_definition.test", "spec.0.additional_printer_column.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_custom_resource_definition.test", "spec.0.additional_printer_column.0.name", "Replicas"),
+					resource.TestCheckResourceAttr("kubernetes_custom_resource_definition.test", "spec.0.additional_printer_column.0.json_path", ".spec.replicas"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesCustomResourceDefinition_importBasic(t *testing.T) {
+	resourceName := "kubernetes_custom_resource_definition.test"
+	plural := strings.ToLower(fmt.Sprintf("tfacctest%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesCustomResourceDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesCustomResourceDefinitionConfig_modified(plural),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesCustomResourceDefinitionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*kubernetesProvider).conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_custom_resource_definition" {
+			continue
+		}
+		resp, err := readCustomResourceDefinition(conn, rs.Primary.ID)
+		if err == nil {
+			if resp.Name == rs.Primary.ID {
+				return fmt.Errorf("Custom resource definition still exists: %s", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesCustomResourceDefinitionExists(n string, obj *customResourceDefinition) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*kubernetesProvider).conn
+		out, err := readCustomResourceDefinition(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*obj = *out
+		return nil
+	}
+}
+
+func testAccKubernetesCustomResourceDefinitionConfig_basic(plural string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_custom_resource_definition" "test" {
+	metadata {
+		name = "%s.terraform.io"
+	}
+	spec {
+		group = "terraform.io"
+		names {
+			plural = "%s"
+			kind   = "TestWidget"
+		}
+		version {
+			name    = "v1"
+			storage = true
+		}
+	}
+}
+`, plural, plural)
+}
+
+func testAccKubernetesCustomResourceDefinitionConfig_modified(plural string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_custom_resource_definition" "test" {
+	metadata {
+		name = "%s.terraform.io"
+		labels {
+			TestLabelOne = "one"
+		}
+	}
+	spec {
+		group = "terraform.io"
+		names {
+			plural      = "%s"
+			kind        = "TestWidget"
+			short_names = ["tw"]
+		}
+		version {
+			name    = "v1"
+			storage = true
+		}
+		validation = <<JSON
+{
+	"properties": {
+		"spec": {
+			"properties": {
+				"replicas": {"type": "integer", "minimum": 1}
+			}
+		}
+	}
+}
+JSON
+		subresources {
+			status = true
+			scale {
+				spec_replicas_path   = ".spec.replicas"
+				status_replicas_path = ".status.replicas"
+			}
+		}
+		additional_printer_column {
+			name      = "Replicas"
+			type      = "integer"
+			json_path = ".spec.replicas"
+		}
+	}
+}
+`, plural, plural)
+}
@@ -32,6 +32,7 @@ func resourceKubernetesDaemonSet() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffConfigChecksum,
 		SchemaVersion: 1,
 		MigrateState:  resourceKubernetesDaemonSetStateUpgrader,
 
@@ -42,7 +43,9 @@ func resourceKubernetesDaemonSet() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"metadata": namespacedMetadataSchema("daemonset", true),
+			"metadata":                 namespacedMetadataSchema("daemonset", true),
+			"rollout_on_config_change": rolloutOnConfigChangeSchema(),
+			"config_checksum":          configChecksumSchema(),
 			"spec": {
 				Type:        schema.TypeList,
 				Description: "Spec defines the specification of the desired behavior of the daemonset. More info: http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#spec-and-status",
@@ -145,14 +148,18 @@ func resourceKubernetesDaemonSet() *schema.Resource {
 	}
 }
 
-func buildDaemonSetObject(d *schema.ResourceData) (*v1.DaemonSet, error) {
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	spec, err := expandDaemonSetSpec(d.Get("spec").([]interface{}))
+func buildDaemonSetObject(d *schema.ResourceData, kp *kubernetesProvider) (*v1.DaemonSet, error) {
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), kp.metadataSettings)
+	spec, err := expandDaemonSetSpec(d.Get("spec").([]interface{}), kp.metadataSettings)
 	if err != nil {
 		return nil, err
 	}
 	if metadata.Namespace == "" {
-		metadata.Namespace = "default"
+		metadata.Namespace = kp.metadataSettings.DefaultNamespace
+	}
+	err = setConfigChecksumAnnotation(d, kp.conn, metadata.Namespace, &spec.Template)
+	if err != nil {
+		return nil, err
 	}
 
 	daemonset := v1.DaemonSet{
@@ -167,7 +174,7 @@ func resourceKubernetesDaemonSetCreate(d *schema.ResourceData, meta interface{})
 	kp := meta.(*kubernetesProvider)
 	conn := kp.conn
 
-	daemonset, err := buildDaemonSetObject(d)
+	daemonset, err := buildDaemonSetObject(d, kp)
 	if err != nil {
 		return err
 	}
@@ -221,16 +228,16 @@ func resourceKubernetesDaemonSetRead(d *schema.ResourceData, meta interface{}) e
 
 	daemonset.ObjectMeta.Labels = reconcileTopLevelLabels(
 		daemonset.ObjectMeta.Labels,
-		expandMetadata(d.Get("metadata").([]interface{})),
-		expandMetadata(d.Get("spec.0.template.0.metadata").([]interface{})),
+		expandMetadata(d.Get("metadata").([]interface{}), kp.metadataSettings),
+		expandMetadata(d.Get("spec.0.template.0.metadata").([]interface{}), kp.metadataSettings),
 	)
 
-	err = d.Set("metadata", flattenMetadata(daemonset.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(daemonset.ObjectMeta, d, kp.metadataSettings))
 	if err != nil {
 		return err
 	}
 
-	spec, err := flattenDaemonSetSpec(daemonset.Spec, d)
+	spec, err := flattenDaemonSetSpec(daemonset.Spec, d, kp.metadataSettings)
 	if err != nil {
 		return err
 	}
@@ -239,6 +246,7 @@ func resourceKubernetesDaemonSetRead(d *schema.ResourceData, meta interface{}) e
 	if err != nil {
 		return err
 	}
+	d.Set("config_checksum", daemonset.Spec.Template.Annotations[configChecksumAnnotation])
 
 	return nil
 }
@@ -285,7 +293,11 @@ func resourceKubernetesDaemonSetUpdate(d *schema.ResourceData, meta interface{})
 	conn := kp.conn
 	namespace, name, err := idParts(d.Id())
 
-	daemonset, err := buildDaemonSetObject(d)
+	daemonset, err := buildDaemonSetObject(d, kp)
+	if err != nil {
+		return err
+	}
+	err = keepIgnoredMetadata(d, kp, &daemonset.ObjectMeta)
 	if err != nil {
 		return err
 	}
@@ -361,21 +373,24 @@ func resourceKubernetesDaemonSetDelete(d *schema.ResourceData, meta interface{})
 	}
 	log.Printf("[INFO] Deleting daemonset: %#v", name)
 
-	policy := metav1.DeletePropagationForeground
+	opts := expandDeleteOptions(d.Get("delete_options").([]interface{}), metav1.DeletePropagationForeground)
 	apiGroup, err := kp.highestSupportedAPIGroup(daemonSetResourceGroupName, daemonSetAPIGroups...)
 	if err != nil {
 		return err
 	}
 	switch apiGroup {
 	case appsV1:
-		conn.AppsV1().DaemonSets(namespace).Delete(name, &metav1.DeleteOptions{PropagationPolicy: &policy})
+		err = conn.AppsV1().DaemonSets(namespace).Delete(name, opts)
 	case appsV1beta2:
-		conn.AppsV1beta2().DaemonSets(namespace).Delete(name, &metav1.DeleteOptions{PropagationPolicy: &policy})
+		err = conn.AppsV1beta2().DaemonSets(namespace).Delete(name, opts)
 	case extensionsV1beta1:
-		conn.ExtensionsV1beta1().DaemonSets(namespace).Delete(name, &metav1.DeleteOptions{PropagationPolicy: &policy})
+		err = conn.ExtensionsV1beta1().DaemonSets(namespace).Delete(name, opts)
 	default:
 		err = daemonSetNotSupportedError
 	}
+	if err != nil {
+		return err
+	}
 
 	log.Printf("[INFO] DaemonSet %s deleted", name)
 
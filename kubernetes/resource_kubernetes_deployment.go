@@ -35,6 +35,7 @@ func resourceKubernetesDeployment() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffConfigChecksum,
 		SchemaVersion: 2,
 		MigrateState:  resourceKubernetesDeploymentStateUpgrader,
 
@@ -45,7 +46,9 @@ func resourceKubernetesDeployment() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"metadata": namespacedMetadataSchema("deployment", true),
+			"metadata":                 namespacedMetadataSchema("deployment", true),
+			"rollout_on_config_change": rolloutOnConfigChangeSchema(),
+			"config_checksum":          configChecksumSchema(),
 			"name": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -191,13 +194,17 @@ func resourceKubernetesDeploymentCreate(d *schema.ResourceData, meta interface{}
 	kp := meta.(*kubernetesProvider)
 	conn := meta.(*kubernetesProvider).conn
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	spec, err := expandDeploymentSpec(d.Get("spec").([]interface{}))
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), kp.metadataSettings)
+	spec, err := expandDeploymentSpec(d.Get("spec").([]interface{}), kp.metadataSettings)
 	if err != nil {
 		return err
 	}
 	if metadata.Namespace == "" {
-		metadata.Namespace = "default"
+		metadata.Namespace = kp.metadataSettings.DefaultNamespace
+	}
+	err = setConfigChecksumAnnotation(d, conn, metadata.Namespace, &spec.Template)
+	if err != nil {
+		return err
 	}
 
 	deployment := appsv1.Deployment{
@@ -326,15 +333,15 @@ func resourceKubernetesDeploymentRead(d *schema.ResourceData, meta interface{})
 
 	deployment.ObjectMeta.Labels = reconcileTopLevelLabels(
 		deployment.ObjectMeta.Labels,
-		expandMetadata(d.Get("metadata").([]interface{})),
-		expandMetadata(d.Get("spec.0.template.0.metadata").([]interface{})),
+		expandMetadata(d.Get("metadata").([]interface{}), kp.metadataSettings),
+		expandMetadata(d.Get("spec.0.template.0.metadata").([]interface{}), kp.metadataSettings),
 	)
-	err = d.Set("metadata", flattenMetadata(deployment.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(deployment.ObjectMeta, d, kp.metadataSettings))
 	if err != nil {
 		return err
 	}
 
-	spec, err := flattenDeploymentSpec(deployment.Spec, d)
+	spec, err := flattenDeploymentSpec(deployment.Spec, d, kp.metadataSettings)
 	if err != nil {
 		return err
 	}
@@ -343,6 +350,7 @@ func resourceKubernetesDeploymentRead(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		return err
 	}
+	d.Set("config_checksum", deployment.Spec.Template.Annotations[configChecksumAnnotation])
 
 	return nil
 }
@@ -351,10 +359,14 @@ func resourceKubernetesDeploymentUpdate(d *schema.ResourceData, meta interface{}
 	kp := meta.(*kubernetesProvider)
 	namespace, name, err := idParts(d.Id())
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, kp)
 
-	if d.HasChange("spec") {
-		spec, err := expandDeploymentSpec(d.Get("spec").([]interface{}))
+	if d.HasChange("spec") || d.HasChange("config_checksum") {
+		spec, err := expandDeploymentSpec(d.Get("spec").([]interface{}), kp.metadataSettings)
+		if err != nil {
+			return err
+		}
+		err = setConfigChecksumAnnotation(d, kp.conn, namespace, &spec.Template)
 		if err != nil {
 			return err
 		}
@@ -450,58 +462,56 @@ func resourceKubernetesDeploymentDelete(d *schema.ResourceData, meta interface{}
 	namespace, name, err := idParts(d.Id())
 	log.Printf("[INFO] Deleting deployment: %#v", name)
 
-	// Drain all replicas before deleting
-	var ops PatchOperations
-	ops = append(ops, &ReplaceOperation{
-		Path:  "/spec/replicas",
-		Value: 0,
-	})
-	data, err := ops.MarshalJSON()
-	if err != nil {
-		return err
-	}
-	_, err = resourceKubernetesPatchDeployment(d, kp, data)
-	if err != nil {
-		return err
-	}
+	opts := expandDeleteOptions(d.Get("delete_options").([]interface{}), metav1.DeletePropagationForeground)
 
-	// Wait until all replicas are gone
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete),
-		waitForDeploymentReplicasFunc(
-			kp,
-			namespace,
-			name,
-		),
-	)
-	if err != nil {
-		return err
+	// Drain all replicas before deleting, unless they are to be orphaned
+	if !isOrphaning(opts) {
+		var ops PatchOperations
+		ops = append(ops, &ReplaceOperation{
+			Path:  "/spec/replicas",
+			Value: 0,
+		})
+		data, err := ops.MarshalJSON()
+		if err != nil {
+			return err
+		}
+		_, err = resourceKubernetesPatchDeployment(d, kp, data)
+		if err != nil {
+			return err
+		}
+
+		// Wait until all replicas are gone
+		err = resource.Retry(d.Timeout(schema.TimeoutDelete),
+			waitForDeploymentReplicasFunc(
+				kp,
+				namespace,
+				name,
+			),
+		)
+		if err != nil {
+			return err
+		}
 	}
 
-	policy := metav1.DeletePropagationForeground
 	apiGroup, err := kp.highestSupportedAPIGroup(deploymentsResourceGroupName, deploymentsAPIGroups...)
 	if err != nil {
 		return err
 	}
 	switch apiGroup {
 	case appsV1:
-		err = conn.AppsV1().Deployments(namespace).Delete(name, &metav1.DeleteOptions{
-			PropagationPolicy: &policy,
-		})
+		err = conn.AppsV1().Deployments(namespace).Delete(name, opts)
 	case appsV1beta2:
-		err = conn.AppsV1beta2().Deployments(namespace).Delete(name, &metav1.DeleteOptions{
-			PropagationPolicy: &policy,
-		})
+		err = conn.AppsV1beta2().Deployments(namespace).Delete(name, opts)
 	case appsV1beta1:
-		err = conn.AppsV1beta1().Deployments(namespace).Delete(name, &metav1.DeleteOptions{
-			PropagationPolicy: &policy,
-		})
+		err = conn.AppsV1beta1().Deployments(namespace).Delete(name, opts)
 	case extensionsV1beta1:
-		err = conn.ExtensionsV1beta1().Deployments(namespace).Delete(name, &metav1.DeleteOptions{
-			PropagationPolicy: &policy,
-		})
+		err = conn.ExtensionsV1beta1().Deployments(namespace).Delete(name, opts)
 	default:
 		err = deploymentNotSupportedError
 	}
+	if err != nil {
+		return err
+	}
 
 	log.Printf("[INFO] Deployment %s deleted", name)
 
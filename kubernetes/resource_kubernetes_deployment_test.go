@@ -234,6 +234,66 @@ func TestAccKubernetesDeployment_noTopLevelLabels(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesDeployment_rolloutOnConfigChange(t *testing.T) {
+	t.Parallel()
+
+	var conf appsv1.Deployment
+	var checksum string
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "kubernetes_deployment.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDeploymentConfigWithConfigMap(name, "first", "nginx:1.7.8"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesDeploymentExists("kubernetes_deployment.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_deployment.test", "rollout_on_config_change", "true"),
+					resource.TestCheckResourceAttrSet("kubernetes_deployment.test", "config_checksum"),
+					resource.TestCheckResourceAttr("kubernetes_deployment.test", "spec.0.template.0.metadata.0.annotations.%", "0"),
+					testAccCheckKubernetesDeploymentConfigChecksum(&conf, &checksum, false),
+				),
+			},
+			{
+				// The config map rolls out the deployment when it's updated, so
+				// the plan following the apply is empty
+				Config: testAccKubernetesDeploymentConfigWithConfigMap(name, "second", "nginx:1.7.8"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesDeploymentExists("kubernetes_deployment.test", &conf),
+					testAccCheckKubernetesDeploymentConfigChecksum(&conf, &checksum, true),
+				),
+			},
+			{
+				// The deployment is updated after the config map in the same
+				// apply and must not stamp the checksum planned before
+				Config: testAccKubernetesDeploymentConfigWithConfigMap(name, "third", "nginx:1.7.9"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesDeploymentExists("kubernetes_deployment.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_deployment.test", "spec.0.template.0.spec.0.container.0.image", "nginx:1.7.9"),
+					testAccCheckKubernetesDeploymentConfigChecksum(&conf, &checksum, true),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesDeploymentConfigChecksum(obj *appsv1.Deployment, previous *string, expectChange bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		current, ok := obj.Spec.Template.Annotations[configChecksumAnnotation]
+		if !ok {
+			return fmt.Errorf("Pod template of %s has no %s annotation", obj.Name, configChecksumAnnotation)
+		}
+		if expectChange && current == *previous {
+			return fmt.Errorf("Expected config checksum of %s to change from %q", obj.Name, *previous)
+		}
+		*previous = current
+		return nil
+	}
+}
+
 func pause() resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		time.Sleep(1 * time.Minute)
@@ -554,3 +614,52 @@ resource "kubernetes_deployment" "test" {
 }
 `, depName, imageName)
 }
+
+func testAccKubernetesDeploymentConfigWithConfigMap(name, value, image string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_config_map" "test" {
+  metadata {
+    name = "%s"
+  }
+
+  data {
+    value = "%s"
+  }
+}
+
+resource "kubernetes_deployment" "test" {
+  metadata {
+    name = "%s"
+  }
+
+  rollout_on_config_change = true
+
+  spec {
+    selector {
+      foo = "bar"
+    }
+
+    template {
+      metadata {
+        labels {
+          foo = "bar"
+        }
+      }
+
+      spec {
+        container {
+          image = "%s"
+          name  = "tf-acc-test"
+
+          env_from {
+            config_map_ref {
+              name = "${kubernetes_config_map.test.metadata.0.name}"
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`, name, value, name, image)
+}
@@ -0,0 +1,260 @@
+package kubernetes
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+)
+
+func resourceKubernetesEndpoints() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceKubernetesEndpointsCreate,
+		Read:          resourceKubernetesEndpointsRead,
+		Exists:        resourceKubernetesEndpointsExists,
+		Update:        resourceKubernetesEndpointsUpdate,
+		Delete:        resourceKubernetesEndpointsDelete,
+		CustomizeDiff: resourceKubernetesEndpointsCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": namespacedMetadataSchema("endpoints", true),
+			"subset": {
+				Type:        schema.TypeSet,
+				Description: "Set of addresses and ports that comprise a service. More info: https://kubernetes.io/docs/concepts/services-networking/service/#services-without-selectors",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: endpointsSubsetFields(),
+				},
+				Set: hashEndpointsSubset(),
+			},
+		},
+	}
+}
+
+func endpointsSubsetFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"address": {
+			Type:        schema.TypeSet,
+			Description: "IP addresses which offer the related ports that are marked as ready. These endpoints should be considered safe for load balancers and clients to utilize.",
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: endpointsSubsetAddressFields(),
+			},
+			Set: hashEndpointsSubsetAddress(),
+		},
+		"not_ready_address": {
+			Type:        schema.TypeSet,
+			Description: "IP addresses which offer the related ports but are not currently marked as ready because they have not yet finished starting, have recently failed a readiness check, or have recently failed a liveness check.",
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: endpointsSubsetAddressFields(),
+			},
+			Set: hashEndpointsSubsetAddress(),
+		},
+		"port": {
+			Type:        schema.TypeSet,
+			Description: "Port numbers available on the related IP addresses.",
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: endpointsSubsetPortFields(),
+			},
+			Set: hashEndpointsSubsetPort(),
+		},
+	}
+}
+
+func endpointsSubsetAddressFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"ip": {
+			Type:        schema.TypeString,
+			Description: "The IP of this endpoint. May not be loopback (127.0.0.0/8), link-local (169.254.0.0/16), or link-local multicast ((224.0.0.0/24).",
+			Required:    true,
+		},
+		"hostname": {
+			Type:        schema.TypeString,
+			Description: "The Hostname of this endpoint.",
+			Optional:    true,
+		},
+		"node_name": {
+			Type:        schema.TypeString,
+			Description: "Node hosting this endpoint. This can be used to determine endpoints local to a node.",
+			Optional:    true,
+		},
+	}
+}
+
+func endpointsSubsetPortFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Description: "The name of this port within the endpoint. Must be a DNS_LABEL. Optional if only one port is defined on this endpoint.",
+			Optional:    true,
+		},
+		"port": {
+			Type:         schema.TypeInt,
+			Description:  "The port that will be exposed by this endpoint.",
+			Required:     true,
+			ValidateFunc: validatePortNum,
+		},
+		"protocol": {
+			Type:        schema.TypeString,
+			Description: "The IP protocol for this port. Supports `TCP` and `UDP`. Default is `TCP`.",
+			Optional:    true,
+			Default:     "TCP",
+		},
+	}
+}
+
+// resourceKubernetesEndpointsCustomizeDiff refuses to create the endpoints of
+// a service with a selector, as the endpoints controller owns them.
+func resourceKubernetesEndpointsCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	name := diff.Get("metadata.0.name").(string)
+	if diff.Id() != "" || name == "" {
+		return nil
+	}
+	kp := meta.(*kubernetesProvider)
+	namespace, known := plannedNamespace(diff, kp.metadataSettings.DefaultNamespace)
+	if !known {
+		return nil
+	}
+
+	conn := kp.conn
+	svc, err := conn.CoreV1().Services(namespace).Get(name, meta_v1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("Failed to read service %q: %s", name, err)
+	}
+	if len(svc.Spec.Selector) > 0 {
+		return fmt.Errorf("Service %q in namespace %q has a selector, its endpoints are managed by Kubernetes", name, namespace)
+	}
+	return nil
+}
+
+func resourceKubernetesEndpointsCreate(d *schema.ResourceData, meta interface{}) error {
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
+
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), kp.metadataSettings)
+	ep := api.Endpoints{
+		ObjectMeta: metadata,
+		Subsets:    expandEndpointsSubsets(d.Get("subset").(*schema.Set)),
+	}
+	log.Printf("[INFO] Creating new endpoints: %#v", ep)
+	out, err := conn.CoreV1().Endpoints(metadata.Namespace).Create(&ep)
+	if err != nil {
+		return fmt.Errorf("Failed to create endpoints: %s", err)
+	}
+	log.Printf("[INFO] Submitted new endpoints: %#v", out)
+	d.SetId(buildId(out.ObjectMeta))
+
+	return resourceKubernetesEndpointsRead(d, meta)
+}
+
+func resourceKubernetesEndpointsRead(d *schema.ResourceData, meta interface{}) error {
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] Reading endpoints %s", name)
+	ep, err := conn.CoreV1().Endpoints(namespace).Get(name, meta_v1.GetOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return err
+	}
+	log.Printf("[INFO] Received endpoints: %#v", ep)
+
+	err = d.Set("metadata", flattenMetadata(ep.ObjectMeta, d, kp.metadataSettings))
+	if err != nil {
+		return err
+	}
+	err = d.Set("subset", flattenEndpointsSubsets(ep.Subsets))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceKubernetesEndpointsUpdate(d *schema.ResourceData, meta interface{}) error {
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	ops := patchMetadata("metadata.0.", "/metadata/", d, kp)
+	if d.HasChange("subset") {
+		// Subsets are serialized as null when empty,
+		// which "add" handles, unlike "replace".
+		ops = append(ops, &AddOperation{
+			Path:  "/subsets",
+			Value: expandEndpointsSubsets(d.Get("subset").(*schema.Set)),
+		})
+	}
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("Failed to marshal update operations: %s", err)
+	}
+	log.Printf("[INFO] Updating endpoints %q: %v", name, string(data))
+	out, err := conn.CoreV1().Endpoints(namespace).Patch(name, pkgApi.JSONPatchType, data)
+	if err != nil {
+		return fmt.Errorf("Failed to update endpoints: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated endpoints: %#v", out)
+	d.SetId(buildId(out.ObjectMeta))
+
+	return resourceKubernetesEndpointsRead(d, meta)
+}
+
+func resourceKubernetesEndpointsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubernetesProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting endpoints: %#v", name)
+	err = conn.CoreV1().Endpoints(namespace).Delete(name, expandDeleteOptions(d.Get("delete_options").([]interface{}), ""))
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Endpoints %s deleted", name)
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKubernetesEndpointsExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	conn := meta.(*kubernetesProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return false, err
+	}
+
+	log.Printf("[INFO] Checking endpoints %s", name)
+	_, err = conn.CoreV1().Endpoints(namespace).Get(name, meta_v1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+	}
+	return true, err
+}
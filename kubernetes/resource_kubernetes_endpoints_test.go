@@ -0,0 +1,250 @@
+package kubernetes
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	api "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAccKubernetesEndpoints_basic(t *testing.T) {
+	var conf api.Endpoints
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "kubernetes_endpoints.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesEndpointsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesEndpointsConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesEndpointsExists("kubernetes_endpoints.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_endpoints.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttr("kubernetes_endpoints.test", "metadata.0.labels.%", "1"),
+					resource.TestCheckResourceAttr("kubernetes_endpoints.test", "metadata.0.labels.TestLabelOne", "one"),
+					resource.TestCheckResourceAttrSet("kubernetes_endpoints.test", "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet("kubernetes_endpoints.test", "metadata.0.uid"),
+					resource.TestCheckResourceAttr("kubernetes_endpoints.test", "subset.#", "1"),
+					testAccCheckKubernetesEndpointsSubsets(&conf, []api.EndpointSubset{
+						{
+							Addresses: []api.EndpointAddress{{IP: "10.0.0.4"}},
+							Ports:     []api.EndpointPort{{Name: "postgres", Port: 5432, Protocol: "TCP"}},
+						},
+					}),
+				),
+			},
+			{
+				Config: testAccKubernetesEndpointsConfig_modified(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesEndpointsExists("kubernetes_endpoints.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_endpoints.test", "metadata.0.labels.%", "1"),
+					resource.TestCheckResourceAttr("kubernetes_endpoints.test", "metadata.0.labels.TestLabelOne", "changed"),
+					resource.TestCheckResourceAttr("kubernetes_endpoints.test", "subset.#", "1"),
+					testAccCheckKubernetesEndpointsSubsets(&conf, []api.EndpointSubset{
+						{
+							Addresses:         []api.EndpointAddress{{IP: "10.0.0.4", Hostname: "db-0"}, {IP: "10.0.0.5", Hostname: "db-1"}},
+							NotReadyAddresses: []api.EndpointAddress{{IP: "10.0.0.6"}},
+							Ports:             []api.EndpointPort{{Name: "postgres", Port: 5432, Protocol: "TCP"}},
+						},
+					}),
+				),
+			},
+			{
+				Config: testAccKubernetesEndpointsConfig_empty(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesEndpointsExists("kubernetes_endpoints.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_endpoints.test", "subset.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesEndpoints_serviceWithSelector(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesEndpointsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesEndpointsConfig_service(name),
+			},
+			{
+				Config:      testAccKubernetesEndpointsConfig_service(name) + testAccKubernetesEndpointsConfig_basic(name),
+				ExpectError: regexp.MustCompile("has a selector"),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesEndpoints_importBasic(t *testing.T) {
+	resourceName := "kubernetes_endpoints.test"
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesEndpointsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesEndpointsConfig_modified(name),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesEndpointsSubsets(ep *api.Endpoints, expected []api.EndpointSubset) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(expected) == 0 && len(ep.Subsets) == 0 {
+			return nil
+		}
+		// The API server sorts addresses and ports
+		if fmt.Sprintf("%v", ep.Subsets) != fmt.Sprintf("%v", expected) {
+			return fmt.Errorf("Endpoints subsets don't match.\nExpected: %v\nGiven: %v", expected, ep.Subsets)
+		}
+		return nil
+	}
+}
+
+func testAccCheckKubernetesEndpointsDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*kubernetesProvider).conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_endpoints" {
+			continue
+		}
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resp, err := conn.CoreV1().Endpoints(namespace).Get(name, meta_v1.GetOptions{})
+		if err == nil {
+			if resp.Namespace == namespace && resp.Name == name {
+				return fmt.Errorf("Endpoints still exist: %s", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesEndpointsExists(n string, obj *api.Endpoints) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*kubernetesProvider).conn
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		out, err := conn.CoreV1().Endpoints(namespace).Get(name, meta_v1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		*obj = *out
+		return nil
+	}
+}
+
+func testAccKubernetesEndpointsConfig_basic(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_endpoints" "test" {
+	metadata {
+		name = "%s"
+		labels {
+			TestLabelOne = "one"
+		}
+	}
+	subset {
+		address {
+			ip = "10.0.0.4"
+		}
+		port {
+			name = "postgres"
+			port = 5432
+		}
+	}
+}
+`, name)
+}
+
+func testAccKubernetesEndpointsConfig_modified(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_endpoints" "test" {
+	metadata {
+		name = "%s"
+		labels {
+			TestLabelOne = "changed"
+		}
+	}
+	subset {
+		address {
+			ip       = "10.0.0.5"
+			hostname = "db-1"
+		}
+		address {
+			ip       = "10.0.0.4"
+			hostname = "db-0"
+		}
+		not_ready_address {
+			ip = "10.0.0.6"
+		}
+		port {
+			name     = "postgres"
+			port     = 5432
+			protocol = "TCP"
+		}
+	}
+}
+`, name)
+}
+
+func testAccKubernetesEndpointsConfig_empty(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_endpoints" "test" {
+	metadata {
+		name = "%s"
+		labels {
+			TestLabelOne = "changed"
+		}
+	}
+}
+`, name)
+}
+
+func testAccKubernetesEndpointsConfig_service(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_service" "test" {
+	metadata {
+		name = "%s"
+	}
+	spec {
+		selector {
+			App = "MyApp"
+		}
+		port {
+			port = 5432
+		}
+	}
+}
+`, name)
+}
@@ -115,9 +115,10 @@ func resourceKubernetesIngress() *schema.Resource {
 }
 
 func resourceKubernetesIngressCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetesProvider).conn
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), kp.metadataSettings)
 	ing := &v1beta1.Ingress{
 		Spec: expandIngressSpec(d.Get("spec").([]interface{})),
 	}
@@ -134,7 +135,8 @@ func resourceKubernetesIngressCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceKubernetesIngressRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetesProvider).conn
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -148,7 +150,7 @@ func resourceKubernetesIngressRead(d *schema.ResourceData, meta interface{}) err
 		return err
 	}
 	log.Printf("[INFO] Received ingress: %#v", ing)
-	err = d.Set("metadata", flattenMetadata(ing.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(ing.ObjectMeta, d, kp.metadataSettings))
 	if err != nil {
 		return err
 	}
@@ -169,18 +171,24 @@ func resourceKubernetesIngressRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceKubernetesIngressUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetesProvider).conn
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
 
 	namespace, _, err := idParts(d.Id())
 	if err != nil {
 		return err
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), kp.metadataSettings)
 	spec := expandIngressSpec(d.Get("spec").([]interface{}))
 
 	if metadata.Namespace == "" {
-		metadata.Namespace = "default"
+		metadata.Namespace = kp.metadataSettings.DefaultNamespace
+	}
+
+	err = keepIgnoredMetadata(d, kp, &metadata)
+	if err != nil {
+		return err
 	}
 
 	ingress := &v1beta1.Ingress{
@@ -206,7 +214,7 @@ func resourceKubernetesIngressDelete(d *schema.ResourceData, meta interface{}) e
 	}
 
 	log.Printf("[INFO] Deleting ingress: %#v", name)
-	err = conn.ExtensionsV1beta1().Ingresses(namespace).Delete(name, &meta_v1.DeleteOptions{})
+	err = conn.ExtensionsV1beta1().Ingresses(namespace).Delete(name, expandDeleteOptions(d.Get("delete_options").([]interface{}), ""))
 	if err != nil {
 		return err
 	}
@@ -42,10 +42,11 @@ func resourceKubernetesJob() *schema.Resource {
 }
 
 func resourceKubernetesJobCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetesProvider).conn
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	spec, err := expandJobSpec(d.Get("spec").([]interface{}))
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), kp.metadataSettings)
+	spec, err := expandJobSpec(d.Get("spec").([]interface{}), kp.metadataSettings)
 	if err != nil {
 		return err
 	}
@@ -69,14 +70,15 @@ func resourceKubernetesJobCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceKubernetesJobUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetesProvider).conn
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return err
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, kp)
 
 	if d.HasChange("spec") {
 		// specOps, err := patchJobSpec("/spec", "spec.0.", d)
@@ -85,7 +87,7 @@ func resourceKubernetesJobUpdate(d *schema.ResourceData, meta interface{}) error
 		// }
 		// ops = append(ops, specOps...)
 
-		spec, err := expandJobSpec(d.Get("spec").([]interface{}))
+		spec, err := expandJobSpec(d.Get("spec").([]interface{}), kp.metadataSettings)
 		if err != nil {
 			return err
 		}
@@ -126,7 +128,8 @@ func resourceKubernetesJobUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceKubernetesJobRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetesProvider).conn
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -162,15 +165,15 @@ func resourceKubernetesJobRead(d *schema.ResourceData, meta interface{}) error {
 
 	job.ObjectMeta.Labels = reconcileTopLevelLabels(
 		job.ObjectMeta.Labels,
-		expandMetadata(d.Get("metadata").([]interface{})),
-		expandMetadata(d.Get("spec.0.template.0.metadata").([]interface{})),
+		expandMetadata(d.Get("metadata").([]interface{}), kp.metadataSettings),
+		expandMetadata(d.Get("spec.0.template.0.metadata").([]interface{}), kp.metadataSettings),
 	)
-	err = d.Set("metadata", flattenMetadata(job.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(job.ObjectMeta, d, kp.metadataSettings))
 	if err != nil {
 		return err
 	}
 
-	jobSpec, err := flattenJobSpec(job.Spec, d)
+	jobSpec, err := flattenJobSpec(job.Spec, d, kp.metadataSettings)
 	if err != nil {
 		return err
 	}
@@ -192,7 +195,7 @@ func resourceKubernetesJobDelete(d *schema.ResourceData, meta interface{}) error
 	}
 
 	log.Printf("[INFO] Deleting job: %#v", name)
-	err = conn.BatchV1().Jobs(namespace).Delete(name, nil)
+	err = conn.BatchV1().Jobs(namespace).Delete(name, expandDeleteOptions(d.Get("delete_options").([]interface{}), ""))
 	if err != nil {
 		return err
 	}
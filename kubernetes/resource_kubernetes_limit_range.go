@@ -3,10 +3,14 @@ package kubernetes
 import (
 	"fmt"
 	"log"
+	"sort"
 
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/schema"
 	api "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	pkgApi "k8s.io/apimachinery/pkg/types"
 )
@@ -21,6 +25,7 @@ func resourceKubernetesLimitRange() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceKubernetesLimitRangeCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"metadata": namespacedMetadataSchema("limit range", true),
@@ -78,10 +83,126 @@ func resourceKubernetesLimitRange() *schema.Resource {
 	}
 }
 
+// resourceKubernetesLimitRangeCustomizeDiff checks the limits against the
+// ordering rules the API server enforces, so violations surface at plan time.
+func resourceKubernetesLimitRangeCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	limits, ok := diff.Get("spec.0.limit").([]interface{})
+	if !ok {
+		return nil
+	}
+	return validateLimitRangeLimits(limits, diff.Id() == "")
+}
+
+// validateLimitRangeLimits mirrors the validation of limit ranges by the API
+// server and reports every violation along with its attribute path.
+func validateLimitRangeLimits(limits []interface{}, isNew bool) error {
+	var errs *multierror.Error
+	for i, l := range limits {
+		limit, ok := l.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		path := fmt.Sprintf("spec.0.limit.%d", i)
+		limitType, _ := limit["type"].(string)
+
+		quantities := make(map[string]map[string]resource.Quantity)
+		for _, k := range []string{"min", "max", "default", "default_request", "max_limit_request_ratio"} {
+			quantities[k] = make(map[string]resource.Quantity)
+			m, _ := limit[k].(map[string]interface{})
+			for name, v := range m {
+				value, ok := v.(string)
+				if !ok || value == config.UnknownVariableValue {
+					continue
+				}
+				q, err := resource.ParseQuantity(value)
+				if err != nil {
+					errs = multierror.Append(errs, fmt.Errorf("%s.%s.%s: %s", path, k, name, err))
+					continue
+				}
+				quantities[k][name] = q
+			}
+		}
+		min := quantities["min"]
+		max := quantities["max"]
+		defaults := quantities["default"]
+		defaultRequests := quantities["default_request"]
+		ratios := quantities["max_limit_request_ratio"]
+
+		switch api.LimitType(limitType) {
+		case api.LimitTypePod:
+			if len(defaults) > 0 {
+				errs = multierror.Append(errs, fmt.Errorf("%s.default: may not be set for Pod limits", path))
+			}
+			// The API server fills in default_request of Pod limits itself
+			if isNew && len(defaultRequests) > 0 {
+				errs = multierror.Append(errs, fmt.Errorf("%s.default_request: may not be set for Pod limits", path))
+			}
+			defaultRequests = nil
+		case api.LimitTypePersistentVolumeClaim:
+			_, minFound := min[string(api.ResourceStorage)]
+			_, maxFound := max[string(api.ResourceStorage)]
+			if !minFound && !maxFound {
+				errs = multierror.Append(errs, fmt.Errorf("%s: either min or max storage is required for PersistentVolumeClaim limits", path))
+			}
+		}
+
+		names := make(map[string]bool)
+		for _, m := range []map[string]resource.Quantity{min, max, defaults, defaultRequests, ratios} {
+			for name := range m {
+				names[name] = true
+			}
+		}
+		sortedNames := make([]string, 0, len(names))
+		for name := range names {
+			sortedNames = append(sortedNames, name)
+		}
+		sort.Strings(sortedNames)
+
+		for _, name := range sortedNames {
+			minQ, minFound := min[name]
+			maxQ, maxFound := max[name]
+			defaultQ, defaultFound := defaults[name]
+			defaultRequestQ, defaultRequestFound := defaultRequests[name]
+			ratioQ, ratioFound := ratios[name]
+
+			if minFound && maxFound && minQ.Cmp(maxQ) > 0 {
+				errs = multierror.Append(errs, fmt.Errorf("%s.min.%s: min value %s is greater than max value %s", path, name, minQ.String(), maxQ.String()))
+			}
+			if defaultRequestFound && minFound && minQ.Cmp(defaultRequestQ) > 0 {
+				errs = multierror.Append(errs, fmt.Errorf("%s.default_request.%s: min value %s is greater than default request value %s", path, name, minQ.String(), defaultRequestQ.String()))
+			}
+			if defaultRequestFound && maxFound && defaultRequestQ.Cmp(maxQ) > 0 {
+				errs = multierror.Append(errs, fmt.Errorf("%s.default_request.%s: default request value %s is greater than max value %s", path, name, defaultRequestQ.String(), maxQ.String()))
+			}
+			if defaultRequestFound && defaultFound && defaultRequestQ.Cmp(defaultQ) > 0 {
+				errs = multierror.Append(errs, fmt.Errorf("%s.default_request.%s: default request value %s is greater than default value %s", path, name, defaultRequestQ.String(), defaultQ.String()))
+			}
+			if defaultFound && minFound && minQ.Cmp(defaultQ) > 0 {
+				errs = multierror.Append(errs, fmt.Errorf("%s.default.%s: min value %s is greater than default value %s", path, name, minQ.String(), defaultQ.String()))
+			}
+			if defaultFound && maxFound && defaultQ.Cmp(maxQ) > 0 {
+				errs = multierror.Append(errs, fmt.Errorf("%s.default.%s: default value %s is greater than max value %s", path, name, defaultQ.String(), maxQ.String()))
+			}
+			if ratioFound && ratioQ.Cmp(*resource.NewQuantity(1, resource.DecimalSI)) < 0 {
+				errs = multierror.Append(errs, fmt.Errorf("%s.max_limit_request_ratio.%s: ratio %s is less than 1", path, name, ratioQ.String()))
+			}
+			if ratioFound && minFound && maxFound && minQ.MilliValue() > 0 {
+				ratio := float64(ratioQ.MilliValue()) / 1000
+				maxRatio := float64(maxQ.MilliValue()) / float64(minQ.MilliValue())
+				if ratio > maxRatio {
+					errs = multierror.Append(errs, fmt.Errorf("%s.max_limit_request_ratio.%s: ratio %s is greater than max/min = %f", path, name, ratioQ.String(), maxRatio))
+				}
+			}
+		}
+	}
+	return errs.ErrorOrNil()
+}
+
 func resourceKubernetesLimitRangeCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetesProvider).conn
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), kp.metadataSettings)
 	spec, err := expandLimitRangeSpec(d.Get("spec").([]interface{}), d.IsNewResource())
 	if err != nil {
 		return err
@@ -102,7 +223,8 @@ func resourceKubernetesLimitRangeCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceKubernetesLimitRangeRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetesProvider).conn
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -116,7 +238,7 @@ func resourceKubernetesLimitRangeRead(d *schema.ResourceData, meta interface{})
 	}
 	log.Printf("[INFO] Received limit range: %#v", limitRange)
 
-	err = d.Set("metadata", flattenMetadata(limitRange.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(limitRange.ObjectMeta, d, kp.metadataSettings))
 	if err != nil {
 		return err
 	}
@@ -129,14 +251,15 @@ func resourceKubernetesLimitRangeRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceKubernetesLimitRangeUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetesProvider).conn
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return err
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, kp)
 	if d.HasChange("spec") {
 		spec, err := expandLimitRangeSpec(d.Get("spec").([]interface{}), d.IsNewResource())
 		if err != nil {
@@ -171,7 +294,7 @@ func resourceKubernetesLimitRangeDelete(d *schema.ResourceData, meta interface{}
 	}
 
 	log.Printf("[INFO] Deleting limit range: %#v", name)
-	err = conn.CoreV1().LimitRanges(namespace).Delete(name, &meta_v1.DeleteOptions{})
+	err = conn.CoreV1().LimitRanges(namespace).Delete(name, expandDeleteOptions(d.Get("delete_options").([]interface{}), ""))
 	if err != nil {
 		return err
 	}
@@ -2,6 +2,8 @@ package kubernetes
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
@@ -264,6 +266,22 @@ func TestAccKubernetesLimitRange_multipleLimits(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesLimitRange_inconsistent(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesLimitRangeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubernetesLimitRangeConfig_inconsistent(name),
+				ExpectError: regexp.MustCompile(`spec\.0\.limit\.0\.default\.cpu: default value 2 is greater than max value 1`),
+			},
+		},
+	})
+}
+
 func TestAccKubernetesLimitRange_importBasic(t *testing.T) {
 	resourceName := "kubernetes_limit_range.test"
 	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
@@ -524,3 +542,123 @@ resource "kubernetes_limit_range" "test" {
 }
 `, name)
 }
+
+func testAccKubernetesLimitRangeConfig_inconsistent(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_limit_range" "test" {
+	metadata {
+		name = "%s"
+	}
+	spec {
+		limit {
+			type = "Container"
+			default {
+				cpu = "2"
+			}
+			max {
+				cpu = "1"
+			}
+		}
+	}
+}
+`, name)
+}
+
+func TestValidateLimitRangeLimits(t *testing.T) {
+	validCases := []map[string]interface{}{
+		{
+			"type":            "Container",
+			"min":             map[string]interface{}{"cpu": "100m"},
+			"default_request": map[string]interface{}{"cpu": "250m"},
+			"default":         map[string]interface{}{"cpu": "500m"},
+			"max":             map[string]interface{}{"cpu": "1"},
+		},
+		{
+			"type":                    "Pod",
+			"min":                     map[string]interface{}{"memory": "64Mi"},
+			"max":                     map[string]interface{}{"memory": "1Gi"},
+			"max_limit_request_ratio": map[string]interface{}{"memory": "4"},
+		},
+		{
+			"type": "PersistentVolumeClaim",
+			"max":  map[string]interface{}{"storage": "10Gi"},
+		},
+	}
+	for _, v := range validCases {
+		err := validateLimitRangeLimits([]interface{}{v}, true)
+		if err != nil {
+			t.Fatalf("Expected %#v to be valid: %s", v, err)
+		}
+	}
+
+	invalidCases := []struct {
+		Limit    map[string]interface{}
+		Expected []string
+	}{
+		{
+			map[string]interface{}{
+				"type":            "Container",
+				"min":             map[string]interface{}{"cpu": "2"},
+				"default_request": map[string]interface{}{"cpu": "1"},
+				"max":             map[string]interface{}{"cpu": "500m"},
+			},
+			[]string{
+				"spec.0.limit.0.min.cpu: min value 2 is greater than max value 500m",
+				"spec.0.limit.0.default_request.cpu: min value 2 is greater than default request value 1",
+				"spec.0.limit.0.default_request.cpu: default request value 1 is greater than max value 500m",
+			},
+		},
+		{
+			map[string]interface{}{
+				"type":            "Container",
+				"default_request": map[string]interface{}{"memory": "1Gi"},
+				"default":         map[string]interface{}{"memory": "512Mi"},
+			},
+			[]string{"spec.0.limit.0.default_request.memory: default request value 1Gi is greater than default value 512Mi"},
+		},
+		{
+			map[string]interface{}{
+				"type":                    "Container",
+				"min":                     map[string]interface{}{"cpu": "500m"},
+				"max":                     map[string]interface{}{"cpu": "1"},
+				"max_limit_request_ratio": map[string]interface{}{"cpu": "4", "memory": "500m"},
+			},
+			[]string{
+				"spec.0.limit.0.max_limit_request_ratio.cpu: ratio 4 is greater than max/min = 2.000000",
+				"spec.0.limit.0.max_limit_request_ratio.memory: ratio 500m is less than 1",
+			},
+		},
+		{
+			map[string]interface{}{
+				"type":            "Pod",
+				"default":         map[string]interface{}{"cpu": "1"},
+				"default_request": map[string]interface{}{"cpu": "1"},
+			},
+			[]string{
+				"spec.0.limit.0.default: may not be set for Pod limits",
+				"spec.0.limit.0.default_request: may not be set for Pod limits",
+			},
+		},
+		{
+			map[string]interface{}{
+				"type": "PersistentVolumeClaim",
+				"max":  map[string]interface{}{"storage": "many"},
+			},
+			[]string{
+				"spec.0.limit.0.max.storage: quantities must match the regular expression",
+				"spec.0.limit.0: either min or max storage is required for PersistentVolumeClaim limits",
+			},
+		},
+	}
+	for _, tc := range invalidCases {
+		err := validateLimitRangeLimits([]interface{}{tc.Limit}, true)
+		if err == nil {
+			t.Fatalf("Expected %#v to be invalid", tc.Limit)
+		}
+		for _, e := range tc.Expected {
+			if !strings.Contains(err.Error(), e) {
+				t.Fatalf("Expected error for %#v to contain %q, given: %s", tc.Limit, e, err)
+			}
+		}
+	}
+}
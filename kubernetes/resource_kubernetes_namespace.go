@@ -1,8 +1,10 @@
 package kubernetes
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform/helper/resource"
@@ -10,7 +12,9 @@ import (
 	api "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sschema "k8s.io/apimachinery/pkg/runtime/schema"
 	pkgApi "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 )
 
 func resourceKubernetesNamespace() *schema.Resource {
@@ -24,6 +28,10 @@ func resourceKubernetesNamespace() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"metadata": metadataSchema("namespace", true),
 		},
@@ -31,9 +39,10 @@ func resourceKubernetesNamespace() *schema.Resource {
 }
 
 func resourceKubernetesNamespaceCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetesProvider).conn
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), kp.metadataSettings)
 	namespace := api.Namespace{
 		ObjectMeta: metadata,
 	}
@@ -49,7 +58,8 @@ func resourceKubernetesNamespaceCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceKubernetesNamespaceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetesProvider).conn
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
 
 	name := d.Id()
 	log.Printf("[INFO] Reading namespace %s", name)
@@ -59,7 +69,7 @@ func resourceKubernetesNamespaceRead(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 	log.Printf("[INFO] Received namespace: %#v", namespace)
-	err = d.Set("metadata", flattenMetadata(namespace.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(namespace.ObjectMeta, d, kp.metadataSettings))
 	if err != nil {
 		return err
 	}
@@ -68,9 +78,10 @@ func resourceKubernetesNamespaceRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceKubernetesNamespaceUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetesProvider).conn
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, kp)
 	data, err := ops.MarshalJSON()
 	if err != nil {
 		return fmt.Errorf("Failed to marshal update operations: %s", err)
@@ -92,7 +103,7 @@ func resourceKubernetesNamespaceDelete(d *schema.ResourceData, meta interface{})
 
 	name := d.Id()
 	log.Printf("[INFO] Deleting namespace: %#v", name)
-	err := conn.CoreV1().Namespaces().Delete(name, &meta_v1.DeleteOptions{})
+	err := conn.CoreV1().Namespaces().Delete(name, expandDeleteOptions(d.Get("delete_options").([]interface{}), ""))
 	if err != nil {
 		return err
 	}
@@ -100,7 +111,7 @@ func resourceKubernetesNamespaceDelete(d *schema.ResourceData, meta interface{})
 	stateConf := &resource.StateChangeConf{
 		Target:  []string{},
 		Pending: []string{"Terminating"},
-		Timeout: 5 * time.Minute,
+		Timeout: d.Timeout(schema.TimeoutDelete),
 		Refresh: func() (interface{}, string, error) {
 			out, err := conn.CoreV1().Namespaces().Get(name, meta_v1.GetOptions{})
 			if err != nil {
@@ -118,6 +129,9 @@ func resourceKubernetesNamespaceDelete(d *schema.ResourceData, meta interface{})
 	}
 	_, err = stateConf.WaitForState()
 	if err != nil {
+		if _, ok := err.(*resource.TimeoutError); ok {
+			return fmt.Errorf("%s%s", err, describeNamespaceTermination(meta.(*kubernetesProvider), name))
+		}
 		return err
 	}
 	log.Printf("[INFO] Namespace %s deleted", name)
@@ -141,3 +155,90 @@ func resourceKubernetesNamespaceExists(d *schema.ResourceData, meta interface{})
 	log.Printf("[INFO] Namespace %s exists", name)
 	return true, err
 }
+
+// describeNamespaceTermination gathers what keeps a namespace in the
+// Terminating phase. It is best effort: failures are only logged.
+func describeNamespaceTermination(kp *kubernetesProvider, name string) string {
+	raw, err := kp.conn.CoreV1().RESTClient().Get().Resource("namespaces").Name(name).DoRaw()
+	if err != nil {
+		log.Printf("[WARN] Failed to read namespace %s: %s", name, err)
+		return ""
+	}
+	ns := &namespace{}
+	err = json.Unmarshal(raw, ns)
+	if err != nil {
+		log.Printf("[WARN] Failed to decode namespace %s: %s", name, err)
+		return ""
+	}
+
+	return stringifyNamespaceTermination(ns, countNamespacedObjects(kp, name))
+}
+
+// countNamespacedObjects counts the objects left in a namespace for every
+// listable namespaced resource type the server offers.
+func countNamespacedObjects(kp *kubernetesProvider, name string) map[string]int {
+	counts := make(map[string]int)
+
+	// Discovery fails partially when an aggregated API is unavailable,
+	// which is a common reason for namespaces to be stuck, so carry on
+	resLists, err := kp.discoClient.ServerPreferredNamespacedResources()
+	if err != nil {
+		log.Printf("[WARN] Failed to discover namespaced resources: %s", err)
+	}
+
+	for _, resList := range resLists {
+		gv, err := k8sschema.ParseGroupVersion(resList.GroupVersion)
+		if err != nil {
+			continue
+		}
+		prefix := "/apis/" + gv.String()
+		if gv.Group == "" {
+			prefix = "/api/" + gv.Version
+		}
+
+		for _, r := range resList.APIResources {
+			if strings.Contains(r.Name, "/") || !isListable(r) {
+				continue
+			}
+			n, err := countObjects(kp.conn, fmt.Sprintf("%s/namespaces/%s/%s", prefix, name, r.Name))
+			if err != nil {
+				log.Printf("[DEBUG] Failed to list %s in namespace %s: %s", r.Name, name, err)
+				continue
+			}
+			if n == 0 {
+				continue
+			}
+			key := r.Name
+			if gv.Group != "" {
+				key += "." + gv.Group
+			}
+			counts[key] = n
+		}
+	}
+
+	return counts
+}
+
+func countObjects(conn *kubernetes.Clientset, path string) (int, error) {
+	raw, err := conn.CoreV1().RESTClient().Get().AbsPath(path).DoRaw()
+	if err != nil {
+		return 0, err
+	}
+	list := struct {
+		Items []json.RawMessage `json:"items"`
+	}{}
+	err = json.Unmarshal(raw, &list)
+	if err != nil {
+		return 0, err
+	}
+	return len(list.Items), nil
+}
+
+func isListable(r meta_v1.APIResource) bool {
+	for _, v := range r.Verbs {
+		if v == "list" {
+			return true
+		}
+	}
+	return false
+}
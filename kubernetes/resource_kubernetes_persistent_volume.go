@@ -50,7 +50,7 @@ func resourceKubernetesPersistentVolume() *schema.Resource {
 					keys := diff.GetChangedKeysPrefix("spec.0.persistent_volume_source")
 					for _, key := range keys {
 						if diff.HasChange(key) {
-							err := diff.ForceNew(key)
+							err := forceNewUnlessRetained(diff, key)
 							if err != nil {
 								return err
 							}
@@ -111,9 +111,10 @@ func resourceKubernetesPersistentVolume() *schema.Resource {
 }
 
 func resourceKubernetesPersistentVolumeCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetesProvider).conn
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), kp.metadataSettings)
 	spec, err := expandPersistentVolumeSpec(d.Get("spec").([]interface{}))
 	if err != nil {
 		return err
@@ -158,7 +159,8 @@ func resourceKubernetesPersistentVolumeCreate(d *schema.ResourceData, meta inter
 }
 
 func resourceKubernetesPersistentVolumeRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetesProvider).conn
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
 
 	name := d.Id()
 	log.Printf("[INFO] Reading persistent volume %s", name)
@@ -168,7 +170,7 @@ func resourceKubernetesPersistentVolumeRead(d *schema.ResourceData, meta interfa
 		return err
 	}
 	log.Printf("[INFO] Received persistent volume: %#v", volume)
-	err = d.Set("metadata", flattenMetadata(volume.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(volume.ObjectMeta, d, kp.metadataSettings))
 	if err != nil {
 		return err
 	}
@@ -181,9 +183,10 @@ func resourceKubernetesPersistentVolumeRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceKubernetesPersistentVolumeUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetesProvider).conn
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, kp)
 	if d.HasChange("spec") {
 		specOps, err := patchPersistentVolumeSpec("/spec", "spec", d)
 		if err != nil {
@@ -212,7 +215,7 @@ func resourceKubernetesPersistentVolumeDelete(d *schema.ResourceData, meta inter
 
 	name := d.Id()
 	log.Printf("[INFO] Deleting persistent volume: %#v", name)
-	err := conn.CoreV1().PersistentVolumes().Delete(name, &meta_v1.DeleteOptions{})
+	err := conn.CoreV1().PersistentVolumes().Delete(name, expandDeleteOptions(d.Get("delete_options").([]interface{}), ""))
 	if err != nil {
 		return err
 	}
@@ -5,12 +5,15 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	api "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	k8sresource "k8s.io/apimachinery/pkg/api/resource"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	pkgApi "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 )
 
 func resourceKubernetesPersistentVolumeClaim() *schema.Resource {
@@ -27,18 +30,88 @@ func resourceKubernetesPersistentVolumeClaim() *schema.Resource {
 			},
 		},
 
+		CustomizeDiff: resourceKubernetesPersistentVolumeClaimCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
 		},
 
 		Schema: persistentVolumeClaimSpecFields(false),
 	}
 }
 
-func resourceKubernetesPersistentVolumeClaimCreate(d *schema.ResourceData, meta interface{}) error {
+// persistentVolumeClaimFileSystemResizePending is set on a claim once the
+// volume was expanded and the file system awaits a resize by the kubelet.
+// It is not part of the vendored API types yet.
+const persistentVolumeClaimFileSystemResizePending api.PersistentVolumeClaimConditionType = "FileSystemResizePending"
+
+func resourceKubernetesPersistentVolumeClaimCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		// We only care about updates, not creation
+		return nil
+	}
+
+	key := "spec.0.resources.0.requests"
+	if !diff.HasChange(key) {
+		return nil
+	}
+
+	o, n := diff.GetChange(key)
+	oldRequests := o.(map[string]interface{})
+	newRequests := n.(map[string]interface{})
+
+	// Only the requested storage can be changed in place
+	for _, m := range []map[string]interface{}{oldRequests, newRequests} {
+		for k := range m {
+			if k != "storage" && oldRequests[k] != newRequests[k] {
+				return forceNewUnlessRetained(diff, key)
+			}
+		}
+	}
+
+	oldStorage, _ := oldRequests["storage"].(string)
+	newStorage, _ := newRequests["storage"].(string)
+	if oldStorage == "" || newStorage == "" || newStorage == config.UnknownVariableValue {
+		return forceNewUnlessRetained(diff, key)
+	}
+	oldQ, err := k8sresource.ParseQuantity(oldStorage)
+	if err != nil {
+		return forceNewUnlessRetained(diff, key)
+	}
+	newQ, err := k8sresource.ParseQuantity(newStorage)
+	if err != nil {
+		return fmt.Errorf("%s.storage: %s", key, err)
+	}
+	if newQ.Cmp(oldQ) < 0 {
+		return fmt.Errorf("%s.storage: persistent volume claims cannot be shrunk (from %s to %s)", key, oldStorage, newStorage)
+	}
+
+	className := diff.Get("spec.0.storage_class_name").(string)
+	if className == "" || diff.HasChange("spec.0.storage_class_name") {
+		return forceNewUnlessRetained(diff, key)
+	}
 	conn := meta.(*kubernetesProvider).conn
+	sc, err := readStorageClass(conn, className)
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+			return forceNewUnlessRetained(diff, key)
+		}
+		return err
+	}
+	if sc.AllowVolumeExpansion == nil || !*sc.AllowVolumeExpansion {
+		log.Printf("[DEBUG] Storage class %s does not allow volume expansion, claim needs to be recreated", className)
+		return forceNewUnlessRetained(diff, key)
+	}
+
+	return nil
+}
+
+func resourceKubernetesPersistentVolumeClaimCreate(d *schema.ResourceData, meta interface{}) error {
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), kp.metadataSettings)
 	spec, err := expandPersistentVolumeClaimSpec(d.Get("spec").([]interface{}))
 	if err != nil {
 		return err
@@ -104,7 +177,8 @@ func resourceKubernetesPersistentVolumeClaimCreate(d *schema.ResourceData, meta
 }
 
 func resourceKubernetesPersistentVolumeClaimRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetesProvider).conn
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -118,7 +192,7 @@ func resourceKubernetesPersistentVolumeClaimRead(d *schema.ResourceData, meta in
 		return err
 	}
 	log.Printf("[INFO] Received persistent volume claim: %#v", claim)
-	err = d.Set("metadata", flattenMetadata(claim.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(claim.ObjectMeta, d, kp.metadataSettings))
 	if err != nil {
 		return err
 	}
@@ -131,15 +205,23 @@ func resourceKubernetesPersistentVolumeClaimRead(d *schema.ResourceData, meta in
 }
 
 func resourceKubernetesPersistentVolumeClaimUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetesProvider).conn
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return err
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
-	// The whole spec is ForceNew = nothing to update there
+	ops := patchMetadata("metadata.0.", "/metadata/", d, kp)
+	// Besides the requested storage the whole spec is ForceNew
+	expand := d.HasChange("spec.0.resources.0.requests.storage")
+	if expand {
+		ops = append(ops, &ReplaceOperation{
+			Path:  "/spec/resources/requests/storage",
+			Value: d.Get("spec.0.resources.0.requests.storage").(string),
+		})
+	}
 	data, err := ops.MarshalJSON()
 	if err != nil {
 		return fmt.Errorf("Failed to marshal update operations: %s", err)
@@ -152,9 +234,43 @@ func resourceKubernetesPersistentVolumeClaimUpdate(d *schema.ResourceData, meta
 	}
 	log.Printf("[INFO] Submitted updated persistent volume claim: %#v", out)
 
+	if expand {
+		err = waitForPersistentVolumeClaimExpansion(conn, namespace, name, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+	}
+
 	return resourceKubernetesPersistentVolumeClaimRead(d, meta)
 }
 
+// waitForPersistentVolumeClaimExpansion waits until the volume backing the claim
+// was expanded, either fully or up to the pending file system resize.
+func waitForPersistentVolumeClaimExpansion(conn *kubernetes.Clientset, namespace, name string, timeout time.Duration) error {
+	return resource.Retry(timeout, func() *resource.RetryError {
+		claim, err := conn.CoreV1().PersistentVolumeClaims(namespace).Get(name, meta_v1.GetOptions{})
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		requested := claim.Spec.Resources.Requests[api.ResourceStorage]
+		capacity := claim.Status.Capacity[api.ResourceStorage]
+		if capacity.Cmp(requested) >= 0 {
+			return nil
+		}
+		for _, c := range claim.Status.Conditions {
+			if c.Type == persistentVolumeClaimFileSystemResizePending && c.Status == api.ConditionTrue {
+				log.Printf("[DEBUG] Persistent volume claim %s awaits a file system resize", name)
+				return nil
+			}
+		}
+
+		err = fmt.Errorf("Persistent volume claim %s is being resized (capacity: %s, requested: %s)",
+			name, capacity.String(), requested.String())
+		return resource.RetryableError(err)
+	})
+}
+
 func resourceKubernetesPersistentVolumeClaimDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*kubernetesProvider).conn
 
@@ -164,7 +280,7 @@ func resourceKubernetesPersistentVolumeClaimDelete(d *schema.ResourceData, meta
 	}
 
 	log.Printf("[INFO] Deleting persistent volume claim: %#v", name)
-	err = conn.CoreV1().PersistentVolumeClaims(namespace).Delete(name, &meta_v1.DeleteOptions{})
+	err = conn.CoreV1().PersistentVolumeClaims(namespace).Delete(name, expandDeleteOptions(d.Get("delete_options").([]interface{}), ""))
 	if err != nil {
 		return err
 	}
@@ -3,6 +3,7 @@ package kubernetes
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
@@ -444,6 +445,50 @@ func TestAccKubernetesPersistentVolumeClaim_googleCloud_storageClass(t *testing.
 	})
 }
 
+func TestAccKubernetesPersistentVolumeClaim_googleCloud_expansion(t *testing.T) {
+	var before, after api.PersistentVolumeClaim
+
+	className := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	claimName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t); skipIfNoGoogleCloudSettingsFound(t) },
+		IDRefreshName: "kubernetes_persistent_volume_claim.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesPersistentVolumeClaimDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPersistentVolumeClaimConfig_expansion(className, claimName, "5Gi"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPersistentVolumeClaimExists("kubernetes_persistent_volume_claim.test", &before),
+					resource.TestCheckResourceAttr("kubernetes_persistent_volume_claim.test", "spec.0.resources.0.requests.storage", "5Gi"),
+				),
+			},
+			{
+				Config: testAccKubernetesPersistentVolumeClaimConfig_expansion(className, claimName, "10Gi"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPersistentVolumeClaimExists("kubernetes_persistent_volume_claim.test", &after),
+					resource.TestCheckResourceAttr("kubernetes_persistent_volume_claim.test", "spec.0.resources.0.requests.storage", "10Gi"),
+					testAccCheckKubernetesPersistentVolumeClaimNotRecreated(&before, &after),
+				),
+			},
+			{
+				Config:      testAccKubernetesPersistentVolumeClaimConfig_expansion(className, claimName, "5Gi"),
+				ExpectError: regexp.MustCompile("persistent volume claims cannot be shrunk"),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesPersistentVolumeClaimNotRecreated(before, after *api.PersistentVolumeClaim) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before.UID != after.UID {
+			return fmt.Errorf("Expected persistent volume claim to be expanded in place, but it was recreated (UID %s -> %s)", before.UID, after.UID)
+		}
+		return nil
+	}
+}
+
 func testAccCheckKubernetesPersistentVolumeClaimDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*kubernetesProvider).conn
 
@@ -921,3 +966,33 @@ resource "kubernetes_persistent_volume_claim" "test" {
 }
 `, className, className, claimName)
 }
+
+func testAccKubernetesPersistentVolumeClaimConfig_expansion(className, claimName, storage string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_storage_class" "test" {
+	metadata {
+		name = "%s"
+	}
+	storage_provisioner    = "kubernetes.io/gce-pd"
+	allow_volume_expansion = true
+	parameters {
+		type = "pd-standard"
+	}
+}
+
+resource "kubernetes_persistent_volume_claim" "test" {
+	metadata {
+		name = "%s"
+	}
+	spec {
+		access_modes = ["ReadWriteOnce"]
+		resources {
+			requests {
+				storage = "%s"
+			}
+		}
+		storage_class_name = "${kubernetes_storage_class.test.metadata.0.name}"
+	}
+}
+`, className, claimName, storage)
+}
@@ -0,0 +1,209 @@
+package kubernetes
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"k8s.io/api/settings/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+)
+
+func resourceKubernetesPodPreset() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKubernetesPodPresetCreate,
+		Read:   resourceKubernetesPodPresetRead,
+		Exists: resourceKubernetesPodPresetExists,
+		Update: resourceKubernetesPodPresetUpdate,
+		Delete: resourceKubernetesPodPresetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": namespacedMetadataSchema("pod preset", true),
+			"spec": {
+				Type:        schema.TypeList,
+				Description: "Spec defines which pods the preset applies to and what it injects into them. More info: https://kubernetes.io/docs/concepts/workloads/pods/podpreset/",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: podPresetSpecFields(),
+				},
+			},
+		},
+	}
+}
+
+func podPresetSpecFields() map[string]*schema.Schema {
+	c := containerFields(true)
+
+	env := c["env"]
+	env.Description = "List of environment variables to inject into the containers of selected pods."
+	envFrom := c["env_from"]
+	envFrom.Description = "List of sources to populate environment variables in the containers of selected pods."
+	volumeMount := c["volume_mount"]
+	volumeMount.Description = "Pod volumes to mount into the filesystem of the containers of selected pods."
+
+	return map[string]*schema.Schema{
+		"selector": {
+			Type:        schema.TypeList,
+			Description: "A label query over the pods the preset applies to.",
+			Required:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: labelSelectorFields(),
+			},
+		},
+		"env":      env,
+		"env_from": envFrom,
+		"volume": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "List of volumes to add to selected pods. More info: http://kubernetes.io/docs/user-guide/volumes",
+			Elem:        volumeSchema(),
+		},
+		"volume_mount": volumeMount,
+	}
+}
+
+func resourceKubernetesPodPresetCreate(d *schema.ResourceData, meta interface{}) error {
+	kp := meta.(*kubernetesProvider)
+
+	// Pod presets are an alpha API, which is disabled unless the API server
+	// has been started with settings.k8s.io/v1alpha1 in --runtime-config.
+	supported, err := kp.serverSupportsResourceAPIVersion("podpresets", settingsV1alpha1.String())
+	if err != nil {
+		return err
+	}
+	if !supported {
+		return fmt.Errorf("Pod presets are not supported by the Kubernetes server: enable the %s API and the PodPreset admission plugin", settingsV1alpha1)
+	}
+
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), kp.metadataSettings)
+	spec, err := expandPodPresetSpec(d.Get("spec").([]interface{}))
+	if err != nil {
+		return err
+	}
+	preset := v1alpha1.PodPreset{
+		ObjectMeta: metadata,
+		Spec:       spec,
+	}
+	log.Printf("[INFO] Creating new pod preset: %#v", preset)
+	out, err := kp.conn.SettingsV1alpha1().PodPresets(metadata.Namespace).Create(&preset)
+	if err != nil {
+		return fmt.Errorf("Failed to create pod preset: %s", err)
+	}
+	log.Printf("[INFO] Submitted new pod preset: %#v", out)
+	d.SetId(buildId(out.ObjectMeta))
+
+	return resourceKubernetesPodPresetRead(d, meta)
+}
+
+func resourceKubernetesPodPresetRead(d *schema.ResourceData, meta interface{}) error {
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] Reading pod preset %s", name)
+	preset, err := conn.SettingsV1alpha1().PodPresets(namespace).Get(name, meta_v1.GetOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return err
+	}
+	log.Printf("[INFO] Received pod preset: %#v", preset)
+
+	err = d.Set("metadata", flattenMetadata(preset.ObjectMeta, d, kp.metadataSettings))
+	if err != nil {
+		return err
+	}
+	spec, err := flattenPodPresetSpec(preset.Spec)
+	if err != nil {
+		return err
+	}
+	err = d.Set("spec", spec)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceKubernetesPodPresetUpdate(d *schema.ResourceData, meta interface{}) error {
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	ops := patchMetadata("metadata.0.", "/metadata/", d, kp)
+	if d.HasChange("spec") {
+		spec, err := expandPodPresetSpec(d.Get("spec").([]interface{}))
+		if err != nil {
+			return err
+		}
+		ops = append(ops, &ReplaceOperation{
+			Path:  "/spec",
+			Value: spec,
+		})
+	}
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("Failed to marshal update operations: %s", err)
+	}
+	log.Printf("[INFO] Updating pod preset %q: %v", name, string(data))
+	out, err := conn.SettingsV1alpha1().PodPresets(namespace).Patch(name, pkgApi.JSONPatchType, data)
+	if err != nil {
+		return fmt.Errorf("Failed to update pod preset: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated pod preset: %#v", out)
+	d.SetId(buildId(out.ObjectMeta))
+
+	return resourceKubernetesPodPresetRead(d, meta)
+}
+
+func resourceKubernetesPodPresetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubernetesProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting pod preset: %#v", name)
+	err = conn.SettingsV1alpha1().PodPresets(namespace).Delete(name, expandDeleteOptions(d.Get("delete_options").([]interface{}), ""))
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Pod preset %s deleted", name)
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKubernetesPodPresetExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	conn := meta.(*kubernetesProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return false, err
+	}
+
+	log.Printf("[INFO] Checking pod preset %s", name)
+	_, err = conn.SettingsV1alpha1().PodPresets(namespace).Get(name, meta_v1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+	}
+	return true, err
+}
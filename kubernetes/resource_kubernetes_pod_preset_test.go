@@ -0,0 +1,207 @@
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"k8s.io/api/settings/v1alpha1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAccKubernetesPodPreset_basic(t *testing.T) {
+	var conf v1alpha1.PodPreset
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfNoPodPresetsAvailable(t)
+		},
+		IDRefreshName: "kubernetes_pod_preset.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesPodPresetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPodPresetConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPodPresetExists("kubernetes_pod_preset.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_pod_preset.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttrSet("kubernetes_pod_preset.test", "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet("kubernetes_pod_preset.test", "metadata.0.uid"),
+					resource.TestCheckResourceAttr("kubernetes_pod_preset.test", "spec.0.selector.0.match_labels.%", "1"),
+					resource.TestCheckResourceAttr("kubernetes_pod_preset.test", "spec.0.selector.0.match_labels.role", "frontend"),
+					resource.TestCheckResourceAttr("kubernetes_pod_preset.test", "spec.0.env.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_pod_preset.test", "spec.0.env.0.name", "DB_PORT"),
+					resource.TestCheckResourceAttr("kubernetes_pod_preset.test", "spec.0.env.0.value", "6379"),
+					resource.TestCheckResourceAttr("kubernetes_pod_preset.test", "spec.0.volume.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_pod_preset.test", "spec.0.volume.0.name", "cache"),
+					resource.TestCheckResourceAttr("kubernetes_pod_preset.test", "spec.0.volume_mount.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_pod_preset.test", "spec.0.volume_mount.0.mount_path", "/cache"),
+				),
+			},
+			{
+				Config: testAccKubernetesPodPresetConfig_modified(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPodPresetExists("kubernetes_pod_preset.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_pod_preset.test", "metadata.0.labels.%", "1"),
+					resource.TestCheckResourceAttr("kubernetes_pod_preset.test", "metadata.0.labels.TestLabelOne", "one"),
+					resource.TestCheckResourceAttr("kubernetes_pod_preset.test", "spec.0.env.#", "2"),
+					resource.TestCheckResourceAttr("kubernetes_pod_preset.test", "spec.0.env.1.name", "DB_HOST"),
+					resource.TestCheckResourceAttr("kubernetes_pod_preset.test", "spec.0.env_from.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_pod_preset.test", "spec.0.env_from.0.config_map_ref.0.name", name),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesPodPreset_importBasic(t *testing.T) {
+	resourceName := "kubernetes_pod_preset.test"
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfNoPodPresetsAvailable(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesPodPresetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPodPresetConfig_basic(name),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesPodPresetDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*kubernetesProvider).conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_pod_preset" {
+			continue
+		}
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resp, err := conn.SettingsV1alpha1().PodPresets(namespace).Get(name, meta_v1.GetOptions{})
+		if err == nil {
+			if resp.Namespace == namespace && resp.Name == name {
+				return fmt.Errorf("Pod preset still exists: %s", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesPodPresetExists(n string, obj *v1alpha1.PodPreset) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*kubernetesProvider).conn
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		out, err := conn.SettingsV1alpha1().PodPresets(namespace).Get(name, meta_v1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		*obj = *out
+		return nil
+	}
+}
+
+func testAccKubernetesPodPresetConfig_basic(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_pod_preset" "test" {
+	metadata {
+		name = "%s"
+	}
+	spec {
+		selector {
+			match_labels {
+				role = "frontend"
+			}
+		}
+		env {
+			name  = "DB_PORT"
+			value = "6379"
+		}
+		volume {
+			name = "cache"
+			empty_dir {}
+		}
+		volume_mount {
+			name       = "cache"
+			mount_path = "/cache"
+		}
+	}
+}
+`, name)
+}
+
+func testAccKubernetesPodPresetConfig_modified(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_config_map" "test" {
+	metadata {
+		name = "%s"
+	}
+	data {
+		DB_USER = "frontend"
+	}
+}
+
+resource "kubernetes_pod_preset" "test" {
+	metadata {
+		name = "%s"
+		labels {
+			TestLabelOne = "one"
+		}
+	}
+	spec {
+		selector {
+			match_labels {
+				role = "frontend"
+			}
+		}
+		env {
+			name  = "DB_PORT"
+			value = "6379"
+		}
+		env {
+			name  = "DB_HOST"
+			value = "redis"
+		}
+		env_from {
+			config_map_ref {
+				name = "${kubernetes_config_map.test.metadata.0.name}"
+			}
+		}
+		volume {
+			name = "cache"
+			empty_dir {}
+		}
+		volume_mount {
+			name       = "cache"
+			mount_path = "/cache"
+		}
+	}
+}
+`, name, name)
+}
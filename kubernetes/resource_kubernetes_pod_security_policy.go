@@ -0,0 +1,404 @@
+package kubernetes
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	api "k8s.io/api/extensions/v1beta1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+)
+
+const podSecurityPoliciesResourceGroupName = "podsecuritypolicies"
+
+var podSecurityPoliciesAPIGroups = []APIGroup{policyV1beta1, extensionsV1beta1}
+
+var podSecurityPolicyNotSupportedError = errors.New("could not find Kubernetes API group that supports PodSecurityPolicy resources")
+
+func resourceKubernetesPodSecurityPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKubernetesPodSecurityPolicyCreate,
+		Read:   resourceKubernetesPodSecurityPolicyRead,
+		Exists: resourceKubernetesPodSecurityPolicyExists,
+		Update: resourceKubernetesPodSecurityPolicyUpdate,
+		Delete: resourceKubernetesPodSecurityPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": metadataSchema("pod security policy", true),
+			"spec": {
+				Type:        schema.TypeList,
+				Description: "Spec defines the policy enforced. More info: https://kubernetes.io/docs/concepts/policy/pod-security-policy/",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"privileged": {
+							Type:        schema.TypeBool,
+							Description: "Determines if a pod can request to be run as privileged.",
+							Optional:    true,
+							Default:     false,
+						},
+						"default_add_capabilities": {
+							Type:        schema.TypeList,
+							Description: "The default set of capabilities that will be added to the container unless the pod spec specifically drops the capability. You may not list a capability in both `default_add_capabilities` and `required_drop_capabilities`.",
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"required_drop_capabilities": {
+							Type:        schema.TypeList,
+							Description: "The capabilities that will be dropped from the container. These are required to be dropped and cannot be added.",
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"allowed_capabilities": {
+							Type:        schema.TypeList,
+							Description: "A list of capabilities that can be requested to add to the container. Capabilities in this field may be added at the pod author's discretion. `*` allows all capabilities.",
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"volumes": {
+							Type:        schema.TypeList,
+							Description: "A whitelist of allowed volume plugins, e.g. `configMap`, `secret` or `persistentVolumeClaim`. `*` allows all volume plugins.",
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"host_network": {
+							Type:        schema.TypeBool,
+							Description: "Determines if the policy allows the use of HostNetwork in the pod spec.",
+							Optional:    true,
+							Default:     false,
+						},
+						"host_pid": {
+							Type:        schema.TypeBool,
+							Description: "Determines if the policy allows the use of HostPID in the pod spec.",
+							Optional:    true,
+							Default:     false,
+						},
+						"host_ipc": {
+							Type:        schema.TypeBool,
+							Description: "Determines if the policy allows the use of HostIPC in the pod spec.",
+							Optional:    true,
+							Default:     false,
+						},
+						"host_ports": {
+							Type:        schema.TypeList,
+							Description: "Determines which host port ranges are allowed to be exposed.",
+							Optional:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"min": {
+										Type:        schema.TypeInt,
+										Description: "Start of the range, inclusive.",
+										Required:    true,
+									},
+									"max": {
+										Type:        schema.TypeInt,
+										Description: "End of the range, inclusive.",
+										Required:    true,
+									},
+								},
+							},
+						},
+						"se_linux": {
+							Type:        schema.TypeList,
+							Description: "The strategy that will dictate the allowable labels that may be set.",
+							Required:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"rule": {
+										Type:         schema.TypeString,
+										Description:  "The strategy that will dictate the allowable labels that may be set. One of `MustRunAs` or `RunAsAny`.",
+										Required:     true,
+										ValidateFunc: validateAttributeValueIsIn([]string{"MustRunAs", "RunAsAny"}),
+									},
+									"se_linux_options": {
+										Type:        schema.TypeList,
+										Description: "The SELinux context to be applied to containers with the `MustRunAs` rule.",
+										Optional:    true,
+										MaxItems:    1,
+										Elem: &schema.Resource{
+											Schema: seLinuxOptionsField(),
+										},
+									},
+								},
+							},
+						},
+						"run_as_user": podSecurityPolicyIDRangeStrategySchema("The strategy that will dictate the allowable RunAsUser values that may be set.",
+							[]string{"MustRunAs", "MustRunAsNonRoot", "RunAsAny"}),
+						"supplemental_groups": podSecurityPolicyIDRangeStrategySchema("The strategy that will dictate what supplemental groups are used by the SecurityContext.",
+							[]string{"MustRunAs", "RunAsAny"}),
+						"fs_group": podSecurityPolicyIDRangeStrategySchema("The strategy that will dictate what fs group is used by the SecurityContext.",
+							[]string{"MustRunAs", "RunAsAny"}),
+						"read_only_root_filesystem": {
+							Type:        schema.TypeBool,
+							Description: "When set to true will force containers to run with a read only root file system. If the container specifically requests to run with a non-read only root file system the policy should deny the pod.",
+							Optional:    true,
+							Default:     false,
+						},
+						"allow_privilege_escalation": {
+							Type:        schema.TypeBool,
+							Description: "Determines if a pod can request to allow privilege escalation.",
+							Optional:    true,
+							Default:     true,
+						},
+						"allowed_host_paths": {
+							Type:        schema.TypeList,
+							Description: "A white list of allowed host paths. Empty indicates that all host paths may be used.",
+							Optional:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"path_prefix": {
+										Type:        schema.TypeString,
+										Description: "The path prefix that the host volume must match, e.g. `/foo` allows `/foo`, `/foo/` and `/foo/bar` but not `/food` or `/etc/foo`.",
+										Required:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func podSecurityPolicyIDRangeStrategySchema(description string, rules []string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: description,
+		Required:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"rule": {
+					Type:         schema.TypeString,
+					Description:  "The strategy that will dictate the allowable IDs. One of `" + strings.Join(rules, "`, `") + "`.",
+					Required:     true,
+					ValidateFunc: validateAttributeValueIsIn(rules),
+				},
+				"range": {
+					Type:        schema.TypeList,
+					Description: "The allowed ranges of IDs for the `MustRunAs` rule.",
+					Optional:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"min": {
+								Type:        schema.TypeInt,
+								Description: "Start of the range, inclusive.",
+								Required:    true,
+							},
+							"max": {
+								Type:        schema.TypeInt,
+								Description: "End of the range, inclusive.",
+								Required:    true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceKubernetesPodSecurityPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	kp := meta.(*kubernetesProvider)
+
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), kp.metadataSettings)
+	psp := api.PodSecurityPolicy{
+		ObjectMeta: metadata,
+		Spec:       expandPodSecurityPolicySpec(d.Get("spec").([]interface{})),
+	}
+	log.Printf("[INFO] Creating new pod security policy: %#v", psp)
+	out, err := createPodSecurityPolicy(kp, &psp)
+	if err != nil {
+		return fmt.Errorf("Failed to create pod security policy: %s", err)
+	}
+	log.Printf("[INFO] Submitted new pod security policy: %#v", out)
+	d.SetId(out.Name)
+
+	return resourceKubernetesPodSecurityPolicyRead(d, meta)
+}
+
+func resourceKubernetesPodSecurityPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	kp := meta.(*kubernetesProvider)
+
+	name := d.Id()
+	log.Printf("[INFO] Reading pod security policy %s", name)
+	psp, err := readPodSecurityPolicy(kp, name)
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return err
+	}
+	log.Printf("[INFO] Received pod security policy: %#v", psp)
+
+	err = d.Set("metadata", flattenMetadata(psp.ObjectMeta, d, kp.metadataSettings))
+	if err != nil {
+		return err
+	}
+	err = d.Set("spec", flattenPodSecurityPolicySpec(psp.Spec))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceKubernetesPodSecurityPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	kp := meta.(*kubernetesProvider)
+
+	name := d.Id()
+	ops := patchMetadata("metadata.0.", "/metadata/", d, kp)
+	if d.HasChange("spec") {
+		ops = append(ops, &ReplaceOperation{
+			Path:  "/spec",
+			Value: expandPodSecurityPolicySpec(d.Get("spec").([]interface{})),
+		})
+	}
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("Failed to marshal update operations: %s", err)
+	}
+	log.Printf("[INFO] Updating pod security policy %q: %v", name, string(data))
+	out, err := patchPodSecurityPolicy(kp, name, data)
+	if err != nil {
+		return fmt.Errorf("Failed to update pod security policy: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated pod security policy: %#v", out)
+
+	return resourceKubernetesPodSecurityPolicyRead(d, meta)
+}
+
+func resourceKubernetesPodSecurityPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	kp := meta.(*kubernetesProvider)
+
+	name := d.Id()
+	log.Printf("[INFO] Deleting pod security policy: %#v", name)
+	err := deletePodSecurityPolicy(kp, name, expandDeleteOptions(d.Get("delete_options").([]interface{}), ""))
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Pod security policy %s deleted", name)
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKubernetesPodSecurityPolicyExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	kp := meta.(*kubernetesProvider)
+
+	name := d.Id()
+	log.Printf("[INFO] Checking pod security policy %s", name)
+	_, err := readPodSecurityPolicy(kp, name)
+	if err != nil {
+		if statusErr, ok := err.(*kerrors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+	}
+	return true, err
+}
+
+// The vendored policy/v1beta1 client predates PodSecurityPolicy, so requests
+// to that group go through its REST client. The policy/v1beta1 and
+// extensions/v1beta1 objects are identical, so the latter types are used.
+
+func createPodSecurityPolicy(kp *kubernetesProvider, psp *api.PodSecurityPolicy) (*api.PodSecurityPolicy, error) {
+	apiGroup, err := kp.highestSupportedAPIGroup(podSecurityPoliciesResourceGroupName, podSecurityPoliciesAPIGroups...)
+	if err != nil {
+		return nil, err
+	}
+	switch apiGroup {
+	case policyV1beta1:
+		body, err := json.Marshal(psp)
+		if err != nil {
+			return nil, err
+		}
+		return decodePodSecurityPolicy(kp.conn.PolicyV1beta1().RESTClient().Post().
+			Resource(podSecurityPoliciesResourceGroupName).
+			Body(body).
+			DoRaw())
+	case extensionsV1beta1:
+		return kp.conn.ExtensionsV1beta1().PodSecurityPolicies().Create(psp)
+	default:
+		return nil, podSecurityPolicyNotSupportedError
+	}
+}
+
+func readPodSecurityPolicy(kp *kubernetesProvider, name string) (*api.PodSecurityPolicy, error) {
+	apiGroup, err := kp.highestSupportedAPIGroup(podSecurityPoliciesResourceGroupName, podSecurityPoliciesAPIGroups...)
+	if err != nil {
+		return nil, err
+	}
+	switch apiGroup {
+	case policyV1beta1:
+		return decodePodSecurityPolicy(kp.conn.PolicyV1beta1().RESTClient().Get().
+			Resource(podSecurityPoliciesResourceGroupName).
+			Name(name).
+			DoRaw())
+	case extensionsV1beta1:
+		return kp.conn.ExtensionsV1beta1().PodSecurityPolicies().Get(name, metav1.GetOptions{})
+	default:
+		return nil, podSecurityPolicyNotSupportedError
+	}
+}
+
+func patchPodSecurityPolicy(kp *kubernetesProvider, name string, data []byte) (*api.PodSecurityPolicy, error) {
+	apiGroup, err := kp.highestSupportedAPIGroup(podSecurityPoliciesResourceGroupName, podSecurityPoliciesAPIGroups...)
+	if err != nil {
+		return nil, err
+	}
+	switch apiGroup {
+	case policyV1beta1:
+		return decodePodSecurityPolicy(kp.conn.PolicyV1beta1().RESTClient().Patch(pkgApi.JSONPatchType).
+			Resource(podSecurityPoliciesResourceGroupName).
+			Name(name).
+			Body(data).
+			DoRaw())
+	case extensionsV1beta1:
+		return kp.conn.ExtensionsV1beta1().PodSecurityPolicies().Patch(name, pkgApi.JSONPatchType, data)
+	default:
+		return nil, podSecurityPolicyNotSupportedError
+	}
+}
+
+func deletePodSecurityPolicy(kp *kubernetesProvider, name string, opts *metav1.DeleteOptions) error {
+	apiGroup, err := kp.highestSupportedAPIGroup(podSecurityPoliciesResourceGroupName, podSecurityPoliciesAPIGroups...)
+	if err != nil {
+		return err
+	}
+	switch apiGroup {
+	case policyV1beta1:
+		_, err = kp.conn.PolicyV1beta1().RESTClient().Delete().
+			Resource(podSecurityPoliciesResourceGroupName).
+			Name(name).
+			Body(opts).
+			DoRaw()
+		return err
+	case extensionsV1beta1:
+		return kp.conn.ExtensionsV1beta1().PodSecurityPolicies().Delete(name, opts)
+	default:
+		return podSecurityPolicyNotSupportedError
+	}
+}
+
+func decodePodSecurityPolicy(raw []byte, err error) (*api.PodSecurityPolicy, error) {
+	if err != nil {
+		return nil, err
+	}
+	psp := &api.PodSecurityPolicy{}
+	err = json.Unmarshal(raw, psp)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to decode pod security policy: %s", err)
+	}
+	return psp, nil
+}
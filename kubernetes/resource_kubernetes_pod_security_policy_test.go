@@ -0,0 +1,215 @@
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	api "k8s.io/api/extensions/v1beta1"
+)
+
+func TestAccKubernetesPodSecurityPolicy_basic(t *testing.T) {
+	var conf api.PodSecurityPolicy
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "kubernetes_pod_security_policy.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesPodSecurityPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPodSecurityPolicyConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPodSecurityPolicyExists("kubernetes_pod_security_policy.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_pod_security_policy.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttr("kubernetes_pod_security_policy.test", "metadata.0.labels.%", "1"),
+					resource.TestCheckResourceAttr("kubernetes_pod_security_policy.test", "metadata.0.labels.TestLabelOne", "one"),
+					resource.TestCheckResourceAttrSet("kubernetes_pod_security_policy.test", "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet("kubernetes_pod_security_policy.test", "metadata.0.uid"),
+					resource.TestCheckResourceAttr("kubernetes_pod_security_policy.test", "spec.0.privileged", "false"),
+					resource.TestCheckResourceAttr("kubernetes_pod_security_policy.test", "spec.0.allow_privilege_escalation", "false"),
+					resource.TestCheckResourceAttr("kubernetes_pod_security_policy.test", "spec.0.required_drop_capabilities.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_pod_security_policy.test", "spec.0.required_drop_capabilities.0", "ALL"),
+					resource.TestCheckResourceAttr("kubernetes_pod_security_policy.test", "spec.0.volumes.#", "3"),
+					resource.TestCheckResourceAttr("kubernetes_pod_security_policy.test", "spec.0.volumes.0", "configMap"),
+					resource.TestCheckResourceAttr("kubernetes_pod_security_policy.test", "spec.0.host_network", "false"),
+					resource.TestCheckResourceAttr("kubernetes_pod_security_policy.test", "spec.0.se_linux.0.rule", "RunAsAny"),
+					resource.TestCheckResourceAttr("kubernetes_pod_security_policy.test", "spec.0.run_as_user.0.rule", "MustRunAsNonRoot"),
+					resource.TestCheckResourceAttr("kubernetes_pod_security_policy.test", "spec.0.supplemental_groups.0.rule", "MustRunAs"),
+					resource.TestCheckResourceAttr("kubernetes_pod_security_policy.test", "spec.0.supplemental_groups.0.range.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_pod_security_policy.test", "spec.0.supplemental_groups.0.range.0.min", "1"),
+					resource.TestCheckResourceAttr("kubernetes_pod_security_policy.test", "spec.0.supplemental_groups.0.range.0.max", "65535"),
+					resource.TestCheckResourceAttr("kubernetes_pod_security_policy.test", "spec.0.fs_group.0.rule", "MustRunAs"),
+					resource.TestCheckResourceAttr("kubernetes_pod_security_policy.test", "spec.0.read_only_root_filesystem", "true"),
+				),
+			},
+			{
+				Config: testAccKubernetesPodSecurityPolicyConfig_modified(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPodSecurityPolicyExists("kubernetes_pod_security_policy.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_pod_security_policy.test", "metadata.0.labels.%", "1"),
+					resource.TestCheckResourceAttr("kubernetes_pod_security_policy.test", "metadata.0.labels.TestLabelOne", "changed"),
+					resource.TestCheckResourceAttr("kubernetes_pod_security_policy.test", "spec.0.privileged", "true"),
+					resource.TestCheckResourceAttr("kubernetes_pod_security_policy.test", "spec.0.allow_privilege_escalation", "true"),
+					resource.TestCheckResourceAttr("kubernetes_pod_security_policy.test", "spec.0.allowed_capabilities.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_pod_security_policy.test", "spec.0.allowed_capabilities.0", "*"),
+					resource.TestCheckResourceAttr("kubernetes_pod_security_policy.test", "spec.0.volumes.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_pod_security_policy.test", "spec.0.volumes.0", "*"),
+					resource.TestCheckResourceAttr("kubernetes_pod_security_policy.test", "spec.0.host_network", "true"),
+					resource.TestCheckResourceAttr("kubernetes_pod_security_policy.test", "spec.0.host_pid", "true"),
+					resource.TestCheckResourceAttr("kubernetes_pod_security_policy.test", "spec.0.host_ipc", "true"),
+					resource.TestCheckResourceAttr("kubernetes_pod_security_policy.test", "spec.0.host_ports.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_pod_security_policy.test", "spec.0.host_ports.0.min", "0"),
+					resource.TestCheckResourceAttr("kubernetes_pod_security_policy.test", "spec.0.host_ports.0.max", "65535"),
+					resource.TestCheckResourceAttr("kubernetes_pod_security_policy.test", "spec.0.run_as_user.0.rule", "RunAsAny"),
+					resource.TestCheckResourceAttr("kubernetes_pod_security_policy.test", "spec.0.supplemental_groups.0.rule", "RunAsAny"),
+					resource.TestCheckResourceAttr("kubernetes_pod_security_policy.test", "spec.0.fs_group.0.rule", "RunAsAny"),
+					resource.TestCheckResourceAttr("kubernetes_pod_security_policy.test", "spec.0.read_only_root_filesystem", "false"),
+					resource.TestCheckResourceAttr("kubernetes_pod_security_policy.test", "spec.0.allowed_host_paths.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_pod_security_policy.test", "spec.0.allowed_host_paths.0.path_prefix", "/var/log"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesPodSecurityPolicy_importBasic(t *testing.T) {
+	resourceName := "kubernetes_pod_security_policy.test"
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesPodSecurityPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPodSecurityPolicyConfig_basic(name),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesPodSecurityPolicyDestroy(s *terraform.State) error {
+	kp := testAccProvider.Meta().(*kubernetesProvider)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_pod_security_policy" {
+			continue
+		}
+		resp, err := readPodSecurityPolicy(kp, rs.Primary.ID)
+		if err == nil {
+			if resp.Name == rs.Primary.ID {
+				return fmt.Errorf("Pod security policy still exists: %s", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesPodSecurityPolicyExists(n string, obj *api.PodSecurityPolicy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		kp := testAccProvider.Meta().(*kubernetesProvider)
+		out, err := readPodSecurityPolicy(kp, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*obj = *out
+		return nil
+	}
+}
+
+func testAccKubernetesPodSecurityPolicyConfig_basic(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_pod_security_policy" "test" {
+	metadata {
+		name = "%s"
+		labels {
+			TestLabelOne = "one"
+		}
+	}
+	spec {
+		privileged                 = false
+		allow_privilege_escalation = false
+		required_drop_capabilities = ["ALL"]
+		volumes                    = ["configMap", "secret", "persistentVolumeClaim"]
+		se_linux {
+			rule = "RunAsAny"
+		}
+		run_as_user {
+			rule = "MustRunAsNonRoot"
+		}
+		supplemental_groups {
+			rule = "MustRunAs"
+			range {
+				min = 1
+				max = 65535
+			}
+		}
+		fs_group {
+			rule = "MustRunAs"
+			range {
+				min = 1
+				max = 65535
+			}
+		}
+		read_only_root_filesystem = true
+	}
+}
+`, name)
+}
+
+func testAccKubernetesPodSecurityPolicyConfig_modified(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_pod_security_policy" "test" {
+	metadata {
+		name = "%s"
+		labels {
+			TestLabelOne = "changed"
+		}
+	}
+	spec {
+		privileged           = true
+		allowed_capabilities = ["*"]
+		volumes              = ["*"]
+		host_network         = true
+		host_pid             = true
+		host_ipc             = true
+		host_ports {
+			min = 0
+			max = 65535
+		}
+		se_linux {
+			rule = "RunAsAny"
+		}
+		run_as_user {
+			rule = "RunAsAny"
+		}
+		supplemental_groups {
+			rule = "RunAsAny"
+		}
+		fs_group {
+			rule = "RunAsAny"
+		}
+		allowed_host_paths {
+			path_prefix = "/var/log"
+		}
+	}
+}
+`, name)
+}
@@ -1,16 +1,17 @@
 package kubernetes
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
-	api "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	pkgApi "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 )
 
 func resourceKubernetesResourceQuota() *schema.Resource {
@@ -48,6 +49,68 @@ func resourceKubernetesResourceQuota() *schema.Resource {
 							Elem:        &schema.Schema{Type: schema.TypeString},
 							Set:         schema.HashString,
 						},
+						"scope_selector": {
+							Type:        schema.TypeList,
+							Description: "A collection of filters like `scopes` that must match each object tracked by a quota, but expressed using scope selector operators. Requires Kubernetes 1.11 or later.",
+							Optional:    true,
+							ForceNew:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"match_expressions": {
+										Type:        schema.TypeList,
+										Description: "A list of scope selector requirements. The requirements are ANDed.",
+										Required:    true,
+										ForceNew:    true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"scope_name": {
+													Type:         schema.TypeString,
+													Description:  "The name of the scope that the selector applies to, e.g. `PriorityClass`.",
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validateAttributeValueIsIn([]string{"Terminating", "NotTerminating", "BestEffort", "NotBestEffort", "PriorityClass"}),
+												},
+												"operator": {
+													Type:         schema.TypeString,
+													Description:  "A scope's relationship to a set of values. Valid operators are `In`, `NotIn`, `Exists` and `DoesNotExist`.",
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validateAttributeValueIsIn([]string{"In", "NotIn", "Exists", "DoesNotExist"}),
+												},
+												"values": {
+													Type:        schema.TypeSet,
+													Description: "An array of string values. If the operator is `In` or `NotIn`, the values array must be non-empty. If the operator is `Exists` or `DoesNotExist`, the values array must be empty.",
+													Optional:    true,
+													ForceNew:    true,
+													Elem:        &schema.Schema{Type: schema.TypeString},
+													Set:         schema.HashString,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"status": {
+				Type:        schema.TypeList,
+				Description: "Status defines the actually enforced quota and its current usage. https://github.com/kubernetes/community/blob/master/contributors/devel/api-conventions.md#spec-and-status",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hard": {
+							Type:        schema.TypeMap,
+							Description: "The set of enforced hard limits for each named resource.",
+							Computed:    true,
+						},
+						"used": {
+							Type:        schema.TypeMap,
+							Description: "The current observed total usage of each named resource in the namespace.",
+							Computed:    true,
+						},
 					},
 				},
 			},
@@ -56,19 +119,22 @@ func resourceKubernetesResourceQuota() *schema.Resource {
 }
 
 func resourceKubernetesResourceQuotaCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetesProvider).conn
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), kp.metadataSettings)
 	spec, err := expandResourceQuotaSpec(d.Get("spec").([]interface{}))
 	if err != nil {
 		return err
 	}
-	resQuota := api.ResourceQuota{
+	resQuota := resourceQuota{
 		ObjectMeta: metadata,
 		Spec:       spec,
 	}
+	resQuota.APIVersion = "v1"
+	resQuota.Kind = "ResourceQuota"
 	log.Printf("[INFO] Creating new resource quota: %#v", resQuota)
-	out, err := conn.CoreV1().ResourceQuotas(metadata.Namespace).Create(&resQuota)
+	out, err := createResourceQuota(conn, &resQuota)
 	if err != nil {
 		return fmt.Errorf("Failed to create resource quota: %s", err)
 	}
@@ -95,7 +161,8 @@ func resourceKubernetesResourceQuotaCreate(d *schema.ResourceData, meta interfac
 }
 
 func resourceKubernetesResourceQuotaRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetesProvider).conn
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -103,7 +170,7 @@ func resourceKubernetesResourceQuotaRead(d *schema.ResourceData, meta interface{
 	}
 
 	log.Printf("[INFO] Reading resource quota %s", name)
-	resQuota, err := conn.CoreV1().ResourceQuotas(namespace).Get(name, meta_v1.GetOptions{})
+	resQuota, err := readResourceQuota(conn, namespace, name)
 	if err != nil {
 		log.Printf("[DEBUG] Received error: %#v", err)
 		return err
@@ -118,7 +185,7 @@ func resourceKubernetesResourceQuotaRead(d *schema.ResourceData, meta interface{
 		}
 	}
 
-	err = d.Set("metadata", flattenMetadata(resQuota.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(resQuota.ObjectMeta, d, kp.metadataSettings))
 	if err != nil {
 		return err
 	}
@@ -126,20 +193,25 @@ func resourceKubernetesResourceQuotaRead(d *schema.ResourceData, meta interface{
 	if err != nil {
 		return err
 	}
+	err = d.Set("status", flattenResourceQuotaStatus(resQuota.Status))
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
 
 func resourceKubernetesResourceQuotaUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetesProvider).conn
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return err
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
-	var spec api.ResourceQuotaSpec
+	ops := patchMetadata("metadata.0.", "/metadata/", d, kp)
+	var spec resourceQuotaSpec
 	waitForChangedSpec := false
 	if d.HasChange("spec") {
 		var err error
@@ -195,7 +267,7 @@ func resourceKubernetesResourceQuotaDelete(d *schema.ResourceData, meta interfac
 	}
 
 	log.Printf("[INFO] Deleting resource quota: %#v", name)
-	err = conn.CoreV1().ResourceQuotas(namespace).Delete(name, &meta_v1.DeleteOptions{})
+	err = conn.CoreV1().ResourceQuotas(namespace).Delete(name, expandDeleteOptions(d.Get("delete_options").([]interface{}), ""))
 	if err != nil {
 		return err
 	}
@@ -224,3 +296,35 @@ func resourceKubernetesResourceQuotaExists(d *schema.ResourceData, meta interfac
 	}
 	return true, err
 }
+
+func createResourceQuota(conn *kubernetes.Clientset, quota *resourceQuota) (*resourceQuota, error) {
+	body, err := json.Marshal(quota)
+	if err != nil {
+		return nil, err
+	}
+	return decodeResourceQuota(conn.CoreV1().RESTClient().Post().
+		Namespace(quota.Namespace).
+		Resource("resourcequotas").
+		Body(body).
+		DoRaw())
+}
+
+func readResourceQuota(conn *kubernetes.Clientset, namespace, name string) (*resourceQuota, error) {
+	return decodeResourceQuota(conn.CoreV1().RESTClient().Get().
+		Namespace(namespace).
+		Resource("resourcequotas").
+		Name(name).
+		DoRaw())
+}
+
+func decodeResourceQuota(raw []byte, err error) (*resourceQuota, error) {
+	if err != nil {
+		return nil, err
+	}
+	quota := &resourceQuota{}
+	err = json.Unmarshal(raw, quota)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to decode resource quota: %s", err)
+	}
+	return quota, nil
+}
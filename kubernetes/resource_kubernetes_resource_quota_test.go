@@ -2,6 +2,7 @@ package kubernetes
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
@@ -42,6 +43,9 @@ func TestAccKubernetesResourceQuota_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("kubernetes_resource_quota.test", "spec.0.hard.limits.cpu", "2"),
 					resource.TestCheckResourceAttr("kubernetes_resource_quota.test", "spec.0.hard.limits.memory", "2Gi"),
 					resource.TestCheckResourceAttr("kubernetes_resource_quota.test", "spec.0.hard.pods", "4"),
+					resource.TestCheckResourceAttr("kubernetes_resource_quota.test", "status.0.hard.%", "3"),
+					resource.TestCheckResourceAttr("kubernetes_resource_quota.test", "status.0.hard.pods", "4"),
+					resource.TestCheckResourceAttr("kubernetes_resource_quota.test", "status.0.used.pods", "0"),
 				),
 			},
 			{
@@ -176,6 +180,40 @@ func TestAccKubernetesResourceQuota_withScopes(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesResourceQuota_withScopeSelector(t *testing.T) {
+	var conf resourceQuota
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "kubernetes_resource_quota.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesResourceQuotaDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesResourceQuotaConfig_withScopeSelector(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesResourceQuotaScopeSelectorExists("kubernetes_resource_quota.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_resource_quota.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttr("kubernetes_resource_quota.test", "spec.0.hard.%", "1"),
+					resource.TestCheckResourceAttr("kubernetes_resource_quota.test", "spec.0.hard.pods", "10"),
+					resource.TestCheckResourceAttr("kubernetes_resource_quota.test", "spec.0.scope_selector.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_resource_quota.test", "spec.0.scope_selector.0.match_expressions.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_resource_quota.test", "spec.0.scope_selector.0.match_expressions.0.scope_name", "PriorityClass"),
+					resource.TestCheckResourceAttr("kubernetes_resource_quota.test", "spec.0.scope_selector.0.match_expressions.0.operator", "In"),
+					resource.TestCheckResourceAttr("kubernetes_resource_quota.test", "spec.0.scope_selector.0.match_expressions.0.values.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_resource_quota.test", "status.0.hard.pods", "10"),
+					testAccCheckKubernetesResourceQuotaScopeSelector(&conf, &resourceQuotaScopeSelector{
+						MatchExpressions: []resourceQuotaScopedSelectorRequirement{
+							{ScopeName: "PriorityClass", Operator: "In", Values: []string{"high"}},
+						},
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKubernetesResourceQuota_importBasic(t *testing.T) {
 	resourceName := "kubernetes_resource_quota.test"
 	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
@@ -246,6 +284,39 @@ func testAccCheckKubernetesResourceQuotaExists(n string, obj *api.ResourceQuota)
 	}
 }
 
+func testAccCheckKubernetesResourceQuotaScopeSelectorExists(n string, obj *resourceQuota) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*kubernetesProvider).conn
+
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		out, err := readResourceQuota(conn, namespace, name)
+		if err != nil {
+			return err
+		}
+
+		*obj = *out
+		return nil
+	}
+}
+
+func testAccCheckKubernetesResourceQuotaScopeSelector(quota *resourceQuota, expected *resourceQuotaScopeSelector) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if !reflect.DeepEqual(quota.Spec.ScopeSelector, expected) {
+			return fmt.Errorf("Scope selector doesn't match.\nExpected: %#v\nGiven: %#v", expected, quota.Spec.ScopeSelector)
+		}
+		return nil
+	}
+}
+
 func testAccKubernetesResourceQuotaConfig_basic(name string) string {
 	return fmt.Sprintf(`
 resource "kubernetes_resource_quota" "test" {
@@ -361,3 +432,25 @@ resource "kubernetes_resource_quota" "test" {
 }
 `, name)
 }
+
+func testAccKubernetesResourceQuotaConfig_withScopeSelector(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_resource_quota" "test" {
+	metadata {
+		name = "%s"
+	}
+	spec {
+		hard {
+			pods = 10
+		}
+		scope_selector {
+			match_expressions {
+				scope_name = "PriorityClass"
+				operator   = "In"
+				values     = ["high"]
+			}
+		}
+	}
+}
+`, name)
+}
@@ -14,11 +14,12 @@ import (
 
 func resourceKubernetesSecret() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceKubernetesSecretCreate,
-		Read:   resourceKubernetesSecretRead,
-		Exists: resourceKubernetesSecretExists,
-		Update: resourceKubernetesSecretUpdate,
-		Delete: resourceKubernetesSecretDelete,
+		Create:        resourceKubernetesSecretCreate,
+		Read:          resourceKubernetesSecretRead,
+		Exists:        resourceKubernetesSecretExists,
+		Update:        resourceKubernetesSecretUpdate,
+		Delete:        resourceKubernetesSecretDelete,
+		CustomizeDiff: customizeDiffBinaryDataOverlap,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -31,6 +32,14 @@ func resourceKubernetesSecret() *schema.Resource {
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"binary_data": {
+				Type:             schema.TypeMap,
+				Description:      "A map of base64 encoded binary secret data. Values are decoded before being stored in the secret.",
+				Optional:         true,
+				Sensitive:        true,
+				ValidateFunc:     validateBase64EncodedMap,
+				DiffSuppressFunc: suppressEquivalentBase64,
+			},
 			"type": {
 				Type:        schema.TypeString,
 				Description: "Type of secret",
@@ -43,14 +52,19 @@ func resourceKubernetesSecret() *schema.Resource {
 }
 
 func resourceKubernetesSecretCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetesProvider).conn
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), kp.metadataSettings)
 	secret := api.Secret{
 		ObjectMeta: metadata,
 		Data:       expandStringMapToByteMap(d.Get("data").(map[string]interface{})),
 	}
 
+	for k, v := range expandBase64MapToByteMap(d.Get("binary_data").(map[string]interface{})) {
+		secret.Data[k] = v
+	}
+
 	if v, ok := d.GetOk("type"); ok {
 		secret.Type = api.SecretType(v.(string))
 	}
@@ -64,11 +78,17 @@ func resourceKubernetesSecretCreate(d *schema.ResourceData, meta interface{}) er
 	log.Printf("[INFO] Submitting new secret: %#v", out)
 	d.SetId(buildId(out.ObjectMeta))
 
+	err = rolloutConfigConsumers(kp, out.Namespace, "", out.Name)
+	if err != nil {
+		return fmt.Errorf("Failed to roll out workloads referring to secret %q: %s", out.Name, err)
+	}
+
 	return resourceKubernetesSecretRead(d, meta)
 }
 
 func resourceKubernetesSecretRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetesProvider).conn
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -82,33 +102,55 @@ func resourceKubernetesSecretRead(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	log.Printf("[INFO] Received secret: %#v", secret)
-	err = d.Set("metadata", flattenMetadata(secret.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(secret.ObjectMeta, d, kp.metadataSettings))
 	if err != nil {
 		return err
 	}
 
-	d.Set("data", byteMapToStringMap(secret.Data))
+	// Keys configured in binary_data are kept there, everything else
+	// (including keys of imported secrets) is exposed via data
+	binaryKeys := d.Get("binary_data").(map[string]interface{})
+	data := make(map[string][]byte)
+	binaryData := make(map[string][]byte)
+	for k, v := range secret.Data {
+		if isKeyInMap(k, binaryKeys) {
+			binaryData[k] = v
+		} else {
+			data[k] = v
+		}
+	}
+	d.Set("data", byteMapToStringMap(data))
+	d.Set("binary_data", base64EncodeByteMap(binaryData))
 	d.Set("type", secret.Type)
 
 	return nil
 }
 
 func resourceKubernetesSecretUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetesProvider).conn
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return err
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
-	if d.HasChange("data") {
-		oldV, newV := d.GetChange("data")
+	ops := patchMetadata("metadata.0.", "/metadata/", d, kp)
+	if d.HasChange("data") || d.HasChange("binary_data") {
+		oldData, newData := d.GetChange("data")
+		oldBinaryData, newBinaryData := d.GetChange("binary_data")
 
-		oldV = base64EncodeStringMap(oldV.(map[string]interface{}))
-		newV = base64EncodeStringMap(newV.(map[string]interface{}))
+		// Both attributes end up in the same field, so they are diffed together
+		oldV := base64EncodeStringMap(oldData.(map[string]interface{}))
+		for k, v := range base64NormalizeStringMap(oldBinaryData.(map[string]interface{})) {
+			oldV[k] = v
+		}
+		newV := base64EncodeStringMap(newData.(map[string]interface{}))
+		for k, v := range base64NormalizeStringMap(newBinaryData.(map[string]interface{})) {
+			newV[k] = v
+		}
 
-		diffOps := diffStringMap("/data/", oldV.(map[string]interface{}), newV.(map[string]interface{}))
+		diffOps := diffStringMap("/data/", oldV, newV)
 
 		ops = append(ops, diffOps...)
 	}
@@ -127,6 +169,13 @@ func resourceKubernetesSecretUpdate(d *schema.ResourceData, meta interface{}) er
 	log.Printf("[INFO] Submitting updated secret: %#v", out)
 	d.SetId(buildId(out.ObjectMeta))
 
+	if d.HasChange("data") || d.HasChange("binary_data") {
+		err = rolloutConfigConsumers(kp, namespace, "", name)
+		if err != nil {
+			return fmt.Errorf("Failed to roll out workloads referring to secret %q: %s", name, err)
+		}
+	}
+
 	return resourceKubernetesSecretRead(d, meta)
 }
 
@@ -139,7 +188,7 @@ func resourceKubernetesSecretDelete(d *schema.ResourceData, meta interface{}) er
 	}
 
 	log.Printf("[INFO] Deleting secret: %q", name)
-	err = conn.CoreV1().Secrets(namespace).Delete(name, &meta_v1.DeleteOptions{})
+	err = conn.CoreV1().Secrets(namespace).Delete(name, expandDeleteOptions(d.Get("delete_options").([]interface{}), ""))
 	if err != nil {
 		return err
 	}
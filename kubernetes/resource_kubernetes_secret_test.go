@@ -240,6 +240,69 @@ func TestAccKubernetesSecret_binaryData(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesSecret_binaryDataAttr(t *testing.T) {
+	var conf api.Secret
+	prefix := "tf-acc-test-gen-"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "kubernetes_secret.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesSecretDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesSecretConfig_binaryDataAttr(prefix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesSecretExists("kubernetes_secret.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_secret.test", "data.%", "1"),
+					resource.TestCheckResourceAttr("kubernetes_secret.test", "data.text", "plain"),
+					resource.TestCheckResourceAttr("kubernetes_secret.test", "binary_data.%", "1"),
+					testAccCheckSecretDataKeys(&conf, []string{"text", "one"}),
+				),
+			},
+			{
+				Config: testAccKubernetesSecretConfig_binaryDataAttr2(prefix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesSecretExists("kubernetes_secret.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_secret.test", "data.%", "0"),
+					resource.TestCheckResourceAttr("kubernetes_secret.test", "binary_data.%", "2"),
+					testAccCheckSecretDataKeys(&conf, []string{"one", "two"}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesSecret_binaryDataOverlap(t *testing.T) {
+	prefix := "tf-acc-test-gen-"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesSecretDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubernetesSecretConfig_binaryDataOverlap(prefix),
+				ExpectError: regexp.MustCompile("binary_data.one: key is also set in data"),
+			},
+		},
+	})
+}
+
+func testAccCheckSecretDataKeys(m *api.Secret, expected []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(m.Data) != len(expected) {
+			return fmt.Errorf("%s has %d data keys, expected %d", m.Name, len(m.Data), len(expected))
+		}
+		for _, k := range expected {
+			if _, ok := m.Data[k]; !ok {
+				return fmt.Errorf("%s data is missing key %q", m.Name, k)
+			}
+		}
+		return nil
+	}
+}
+
 func testAccCheckSecretData(m *api.Secret, expected map[string]string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if len(expected) == 0 && len(m.Data) == 0 {
@@ -424,3 +487,46 @@ resource "kubernetes_secret" "test" {
 	}
 }`, prefix)
 }
+
+func testAccKubernetesSecretConfig_binaryDataAttr(prefix string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_secret" "test" {
+	metadata {
+		generate_name = "%s"
+	}
+	data {
+		text = "plain"
+	}
+	binary_data {
+		one = "${base64encode(file("./test-fixtures/binary.data"))}"
+	}
+}`, prefix)
+}
+
+func testAccKubernetesSecretConfig_binaryDataAttr2(prefix string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_secret" "test" {
+	metadata {
+		generate_name = "%s"
+	}
+	binary_data {
+		one = "${base64encode(file("./test-fixtures/binary2.data"))}"
+		two = "${base64encode(file("./test-fixtures/binary.data"))}"
+	}
+}`, prefix)
+}
+
+func testAccKubernetesSecretConfig_binaryDataOverlap(prefix string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_secret" "test" {
+	metadata {
+		generate_name = "%s"
+	}
+	data {
+		one = "plain"
+	}
+	binary_data {
+		one = "${base64encode(file("./test-fixtures/binary.data"))}"
+	}
+}`, prefix)
+}
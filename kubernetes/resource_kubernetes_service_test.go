@@ -117,6 +117,9 @@ func TestAccKubernetesService_loadBalancer(t *testing.T) {
 					resource.TestCheckResourceAttr("kubernetes_service.test", "spec.0.selector.%", "1"),
 					resource.TestCheckResourceAttr("kubernetes_service.test", "spec.0.selector.App", "MyApp"),
 					resource.TestCheckResourceAttr("kubernetes_service.test", "spec.0.session_affinity", "ClientIP"),
+					resource.TestCheckResourceAttr("kubernetes_service.test", "spec.0.session_affinity_config.0.client_ip.0.timeout_seconds", "10800"),
+					resource.TestCheckResourceAttr("kubernetes_service.test", "spec.0.external_traffic_policy", "Cluster"),
+					resource.TestCheckResourceAttr("kubernetes_service.test", "spec.0.publish_not_ready_addresses", "false"),
 					resource.TestCheckResourceAttr("kubernetes_service.test", "spec.0.type", "LoadBalancer"),
 					testAccCheckServicePorts(&conf, []api.ServicePort{
 						{
@@ -150,6 +153,10 @@ func TestAccKubernetesService_loadBalancer(t *testing.T) {
 					resource.TestCheckResourceAttr("kubernetes_service.test", "spec.0.selector.App", "MyModifiedApp"),
 					resource.TestCheckResourceAttr("kubernetes_service.test", "spec.0.selector.NewSelector", "NewValue"),
 					resource.TestCheckResourceAttr("kubernetes_service.test", "spec.0.session_affinity", "ClientIP"),
+					resource.TestCheckResourceAttr("kubernetes_service.test", "spec.0.session_affinity_config.0.client_ip.0.timeout_seconds", "300"),
+					resource.TestCheckResourceAttr("kubernetes_service.test", "spec.0.external_traffic_policy", "Local"),
+					resource.TestCheckResourceAttrSet("kubernetes_service.test", "spec.0.health_check_node_port"),
+					resource.TestCheckResourceAttr("kubernetes_service.test", "spec.0.publish_not_ready_addresses", "true"),
 					resource.TestCheckResourceAttr("kubernetes_service.test", "spec.0.type", "LoadBalancer"),
 					testAccCheckServicePorts(&conf, []api.ServicePort{
 						{
@@ -160,6 +167,15 @@ func TestAccKubernetesService_loadBalancer(t *testing.T) {
 					}),
 				),
 			},
+			{
+				Config: testAccKubernetesServiceConfig_loadBalancer_healthCheckNodePort(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesServiceExists("kubernetes_service.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_service.test", "spec.0.external_traffic_policy", "Local"),
+					resource.TestCheckResourceAttr("kubernetes_service.test", "spec.0.health_check_node_port", "31913"),
+					resource.TestCheckResourceAttr("kubernetes_service.test", "spec.0.type", "LoadBalancer"),
+				),
+			},
 		},
 	})
 }
@@ -536,16 +552,44 @@ resource "kubernetes_service" "test" {
 			NewSelector = "NewValue"
 		}
 		session_affinity = "ClientIP"
+		session_affinity_config {
+			client_ip {
+				timeout_seconds = 300
+			}
+		}
 		port {
 			port = 9999
 			target_port = 81
 		}
 		type = "LoadBalancer"
 		load_balancer_ip = "100.24.12.6" // New line added
+		external_traffic_policy = "Local"
+		publish_not_ready_addresses = true
 	}
 }`, name, name)
 }
 
+func testAccKubernetesServiceConfig_loadBalancer_healthCheckNodePort(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_service" "test" {
+	metadata {
+		name = "%s"
+	}
+	spec {
+		selector {
+			App = "MyModifiedApp"
+		}
+		port {
+			port = 9999
+			target_port = 81
+		}
+		type = "LoadBalancer"
+		external_traffic_policy = "Local"
+		health_check_node_port = 31913
+	}
+}`, name)
+}
+
 func testAccKubernetesServiceConfig_nodePort(name string) string {
 	return fmt.Sprintf(`
 resource "kubernetes_service" "test" {
@@ -31,10 +31,13 @@ func resourceKubernetesStatefulSet() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffConfigChecksum,
 		SchemaVersion: 1,
 		MigrateState:  resourceKubernetesStatefulSetStateUpgrader,
 		Schema: map[string]*schema.Schema{
-			"metadata": namespacedMetadataSchema("statefulset", true),
+			"metadata":                 namespacedMetadataSchema("statefulset", true),
+			"rollout_on_config_change": rolloutOnConfigChangeSchema(),
+			"config_checksum":          configChecksumSchema(),
 			"spec": {
 				Type:        schema.TypeList,
 				Description: "Spec defines the specification of the desired behavior of the StatefulSet. More info: http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#spec-and-status",
@@ -159,15 +162,19 @@ func resourceKubernetesStatefulSetCreate(d *schema.ResourceData, meta interface{
 	kp := meta.(*kubernetesProvider)
 	conn := kp.conn
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	spec, err := expandStatefulSetSpec(d.Get("spec").([]interface{}))
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), kp.metadataSettings)
+	spec, err := expandStatefulSetSpec(d.Get("spec").([]interface{}), kp.metadataSettings)
 	if err != nil {
 		return err
 	}
 
 	//use name as label and selector if not set
 	if metadata.Namespace == "" {
-		metadata.Namespace = "default"
+		metadata.Namespace = kp.metadataSettings.DefaultNamespace
+	}
+	err = setConfigChecksumAnnotation(d, conn, metadata.Namespace, &spec.Template)
+	if err != nil {
+		return err
 	}
 
 	statefulSetV1 := v1.StatefulSet{
@@ -239,15 +246,15 @@ func resourceKubernetesStatefulSetRead(d *schema.ResourceData, meta interface{})
 
 	statefulSet.ObjectMeta.Labels = reconcileTopLevelLabels(
 		statefulSet.ObjectMeta.Labels,
-		expandMetadata(d.Get("metadata").([]interface{})),
-		expandMetadata(d.Get("spec.0.template.0.metadata").([]interface{})),
+		expandMetadata(d.Get("metadata").([]interface{}), kp.metadataSettings),
+		expandMetadata(d.Get("spec.0.template.0.metadata").([]interface{}), kp.metadataSettings),
 	)
-	err = d.Set("metadata", flattenMetadata(statefulSet.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(statefulSet.ObjectMeta, d, kp.metadataSettings))
 	if err != nil {
 		return err
 	}
 
-	spec, err := flattenStatefulSetSpec(statefulSet.Spec, d)
+	spec, err := flattenStatefulSetSpec(statefulSet.Spec, d, kp.metadataSettings)
 	if err != nil {
 		return err
 	}
@@ -256,6 +263,7 @@ func resourceKubernetesStatefulSetRead(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		return err
 	}
+	d.Set("config_checksum", statefulSet.Spec.Template.Annotations[configChecksumAnnotation])
 
 	return nil
 }
@@ -265,10 +273,14 @@ func resourceKubernetesStatefulSetUpdate(d *schema.ResourceData, meta interface{
 
 	namespace, name, err := idParts(d.Id())
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, kp)
 
-	if d.HasChange("spec") {
-		spec, err := expandStatefulSetSpec(d.Get("spec").([]interface{}))
+	if d.HasChange("spec") || d.HasChange("config_checksum") {
+		spec, err := expandStatefulSetSpec(d.Get("spec").([]interface{}), kp.metadataSettings)
+		if err != nil {
+			return err
+		}
+		err = setConfigChecksumAnnotation(d, kp.conn, namespace, &spec.Template)
 		if err != nil {
 			return err
 		}
@@ -307,27 +319,31 @@ func resourceKubernetesStatefulSetDelete(d *schema.ResourceData, meta interface{
 	namespace, name, err := idParts(d.Id())
 	log.Printf("[INFO] Deleting statefulSet: %#v", name)
 
-	// Drain all replicas before deleting
-	var ops PatchOperations
-	ops = append(ops, &ReplaceOperation{
-		Path:  "/spec/replicas",
-		Value: 0,
-	})
-	data, err := ops.MarshalJSON()
-	if err != nil {
-		return err
-	}
+	opts := expandDeleteOptions(d.Get("delete_options").([]interface{}), "")
 
-	_, err = patchStatefulSet(d, kp, data)
-	if err != nil {
-		return err
-	}
+	// Drain all replicas before deleting, unless they are to be orphaned
+	if !isOrphaning(opts) {
+		var ops PatchOperations
+		ops = append(ops, &ReplaceOperation{
+			Path:  "/spec/replicas",
+			Value: 0,
+		})
+		data, err := ops.MarshalJSON()
+		if err != nil {
+			return err
+		}
 
-	// Wait until all replicas are gone
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete),
-		waitForStatefulSetReplicasFunc(kp, namespace, name))
-	if err != nil {
-		return err
+		_, err = patchStatefulSet(d, kp, data)
+		if err != nil {
+			return err
+		}
+
+		// Wait until all replicas are gone
+		err = resource.Retry(d.Timeout(schema.TimeoutDelete),
+			waitForStatefulSetReplicasFunc(kp, namespace, name))
+		if err != nil {
+			return err
+		}
 	}
 
 	apiGroup, err := kp.highestSupportedAPIGroup(statefulSetResourceGroupName, statefulSetAPIGroups...)
@@ -336,11 +352,11 @@ func resourceKubernetesStatefulSetDelete(d *schema.ResourceData, meta interface{
 	}
 	switch apiGroup {
 	case appsV1:
-		err = conn.AppsV1().StatefulSets(namespace).Delete(name, &metav1.DeleteOptions{})
+		err = conn.AppsV1().StatefulSets(namespace).Delete(name, opts)
 	case appsV1beta2:
-		err = conn.AppsV1beta2().StatefulSets(namespace).Delete(name, &metav1.DeleteOptions{})
+		err = conn.AppsV1beta2().StatefulSets(namespace).Delete(name, opts)
 	case appsV1beta1:
-		err = conn.AppsV1beta1().StatefulSets(namespace).Delete(name, &metav1.DeleteOptions{})
+		err = conn.AppsV1beta1().StatefulSets(namespace).Delete(name, opts)
 	default:
 		err = statefulSetNotSupportedError
 	}
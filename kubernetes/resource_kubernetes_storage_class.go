@@ -1,6 +1,7 @@
 package kubernetes
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 
@@ -10,6 +11,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	pkgApi "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 )
 
 func resourceKubernetesStorageClass() *schema.Resource {
@@ -44,18 +46,83 @@ func resourceKubernetesStorageClass() *schema.Resource {
 				Required:    true,
 				ForceNew:    true,
 			},
+			"volume_binding_mode": {
+				Type:         schema.TypeString,
+				Description:  "Indicates when volumes should be provisioned and bound, either `Immediate` or `WaitForFirstConsumer`, which delays it until a pod using the claim is scheduled",
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAttributeValueIsIn([]string{string(api.VolumeBindingImmediate), string(api.VolumeBindingWaitForFirstConsumer)}),
+			},
+			"allowed_topologies": {
+				Type:        schema.TypeList,
+				Description: "Restricts the topologies in which volumes can be provisioned, e.g. to zones. Each term is ORed, an empty list allows all topologies",
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Resource{
+					Schema: topologySelectorTermFields(),
+				},
+			},
+			"mount_options": {
+				Type:        schema.TypeSet,
+				Description: "Mount options of persistent volumes provisioned by this storage class, e.g. `debug`",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+			},
+			"allow_volume_expansion": {
+				Type:        schema.TypeBool,
+				Description: "Whether persistent volume claims of this storage class may be expanded",
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+func topologySelectorTermFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"match_label_expressions": {
+			Type:        schema.TypeList,
+			Description: "A list of topology selector requirements by labels. The requirements are ANDed",
+			Required:    true,
+			ForceNew:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"key": {
+						Type:        schema.TypeString,
+						Description: "The label key that the selector applies to, e.g. `failure-domain.beta.kubernetes.io/zone`",
+						Required:    true,
+						ForceNew:    true,
+					},
+					"values": {
+						Type:        schema.TypeSet,
+						Description: "An array of string values. One value must match the label to be selected",
+						Required:    true,
+						ForceNew:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Set:         schema.HashString,
+					},
+				},
+			},
 		},
 	}
 }
 
 func resourceKubernetesStorageClassCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetesProvider).conn
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	storageClass := api.StorageClass{
-		ObjectMeta:  metadata,
-		Provisioner: d.Get("storage_provisioner").(string),
+	metadata := expandMetadata(d.Get("metadata").([]interface{}), kp.metadataSettings)
+	storageClass := storageClass{
+		StorageClass: api.StorageClass{
+			ObjectMeta:  metadata,
+			Provisioner: d.Get("storage_provisioner").(string),
+		},
+		AllowedTopologies: expandTopologySelectorTerms(d.Get("allowed_topologies").([]interface{})),
 	}
+	storageClass.APIVersion = "storage.k8s.io/v1"
+	storageClass.Kind = "StorageClass"
 
 	if v, ok := d.GetOk("reclaim_policy"); ok && v != "" {
 		storageClass.ReclaimPolicy = new(corev1.PersistentVolumeReclaimPolicy)
@@ -66,8 +133,19 @@ func resourceKubernetesStorageClassCreate(d *schema.ResourceData, meta interface
 		storageClass.Parameters = expandStringMap(v.(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("volume_binding_mode"); ok {
+		mode := api.VolumeBindingMode(v.(string))
+		storageClass.VolumeBindingMode = &mode
+	}
+
+	if v, ok := d.GetOk("mount_options"); ok {
+		storageClass.MountOptions = sliceOfString(v.(*schema.Set).List())
+	}
+
+	storageClass.AllowVolumeExpansion = ptrToBool(d.Get("allow_volume_expansion").(bool))
+
 	log.Printf("[INFO] Creating new storage class: %#v", storageClass)
-	out, err := conn.StorageV1().StorageClasses().Create(&storageClass)
+	out, err := createStorageClass(conn, &storageClass)
 	if err != nil {
 		return err
 	}
@@ -78,32 +156,63 @@ func resourceKubernetesStorageClassCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceKubernetesStorageClassRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetesProvider).conn
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
 
 	name := d.Id()
 	log.Printf("[INFO] Reading storage class %s", name)
-	storageClass, err := conn.StorageV1().StorageClasses().Get(name, metav1.GetOptions{})
+	storageClass, err := readStorageClass(conn, name)
 	if err != nil {
 		log.Printf("[DEBUG] Received error: %#v", err)
 		return err
 	}
 	log.Printf("[INFO] Received storage class: %#v", storageClass)
-	err = d.Set("metadata", flattenMetadata(storageClass.ObjectMeta, d))
+
+	return setStorageClassAttributes(d, storageClass, kp.metadataSettings)
+}
+
+func setStorageClassAttributes(d *schema.ResourceData, storageClass *storageClass, settings metadataSettings) error {
+	err := d.Set("metadata", flattenMetadata(storageClass.ObjectMeta, d, settings))
 	if err != nil {
 		return err
 	}
 	d.Set("reclaim_policy", storageClass.ReclaimPolicy)
 	d.Set("parameters", storageClass.Parameters)
 	d.Set("storage_provisioner", storageClass.Provisioner)
+	if storageClass.VolumeBindingMode != nil {
+		d.Set("volume_binding_mode", string(*storageClass.VolumeBindingMode))
+	}
+	err = d.Set("allowed_topologies", flattenTopologySelectorTerms(storageClass.AllowedTopologies))
+	if err != nil {
+		return err
+	}
+	err = d.Set("mount_options", newStringSet(schema.HashString, storageClass.MountOptions))
+	if err != nil {
+		return err
+	}
+	d.Set("allow_volume_expansion", storageClass.AllowVolumeExpansion != nil && *storageClass.AllowVolumeExpansion)
 
 	return nil
 }
 
 func resourceKubernetesStorageClassUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetesProvider).conn
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
 
 	name := d.Id()
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	ops := patchMetadata("metadata.0.", "/metadata/", d, kp)
+	if d.HasChange("mount_options") {
+		ops = append(ops, &AddOperation{
+			Path:  "/mountOptions",
+			Value: sliceOfString(d.Get("mount_options").(*schema.Set).List()),
+		})
+	}
+	if d.HasChange("allow_volume_expansion") {
+		ops = append(ops, &AddOperation{
+			Path:  "/allowVolumeExpansion",
+			Value: d.Get("allow_volume_expansion").(bool),
+		})
+	}
 	data, err := ops.MarshalJSON()
 	if err != nil {
 		return fmt.Errorf("Failed to marshal update operations: %s", err)
@@ -124,7 +233,7 @@ func resourceKubernetesStorageClassDelete(d *schema.ResourceData, meta interface
 
 	name := d.Id()
 	log.Printf("[INFO] Deleting storage class: %#v", name)
-	err := conn.StorageV1().StorageClasses().Delete(name, &metav1.DeleteOptions{})
+	err := conn.StorageV1().StorageClasses().Delete(name, expandDeleteOptions(d.Get("delete_options").([]interface{}), ""))
 	if err != nil {
 		return err
 	}
@@ -149,3 +258,33 @@ func resourceKubernetesStorageClassExists(d *schema.ResourceData, meta interface
 	}
 	return true, err
 }
+
+func createStorageClass(conn *kubernetes.Clientset, sc *storageClass) (*storageClass, error) {
+	body, err := json.Marshal(sc)
+	if err != nil {
+		return nil, err
+	}
+	return decodeStorageClass(conn.StorageV1().RESTClient().Post().
+		Resource("storageclasses").
+		Body(body).
+		DoRaw())
+}
+
+func readStorageClass(conn *kubernetes.Clientset, name string) (*storageClass, error) {
+	return decodeStorageClass(conn.StorageV1().RESTClient().Get().
+		Resource("storageclasses").
+		Name(name).
+		DoRaw())
+}
+
+func decodeStorageClass(raw []byte, err error) (*storageClass, error) {
+	if err != nil {
+		return nil, err
+	}
+	sc := &storageClass{}
+	err = json.Unmarshal(raw, sc)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to decode storage class: %s", err)
+	}
+	return sc, nil
+}
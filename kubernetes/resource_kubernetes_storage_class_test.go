@@ -96,6 +96,44 @@ func TestAccKubernetesStorageClass_basic(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesStorageClass_topologies(t *testing.T) {
+	var conf api.StorageClass
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "kubernetes_storage_class.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesStorageClassDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesStorageClassConfig_topologies(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesStorageClassExists("kubernetes_storage_class.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_storage_class.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttr("kubernetes_storage_class.test", "volume_binding_mode", "WaitForFirstConsumer"),
+					resource.TestCheckResourceAttr("kubernetes_storage_class.test", "allowed_topologies.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_storage_class.test", "allowed_topologies.0.match_label_expressions.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_storage_class.test", "allowed_topologies.0.match_label_expressions.0.key", "failure-domain.beta.kubernetes.io/zone"),
+					resource.TestCheckResourceAttr("kubernetes_storage_class.test", "allowed_topologies.0.match_label_expressions.0.values.#", "2"),
+					resource.TestCheckResourceAttr("kubernetes_storage_class.test", "mount_options.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_storage_class.test", "allow_volume_expansion", "false"),
+				),
+			},
+			{
+				Config: testAccKubernetesStorageClassConfig_topologiesModified(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesStorageClassExists("kubernetes_storage_class.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_storage_class.test", "volume_binding_mode", "WaitForFirstConsumer"),
+					resource.TestCheckResourceAttr("kubernetes_storage_class.test", "allowed_topologies.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_storage_class.test", "mount_options.#", "2"),
+					resource.TestCheckResourceAttr("kubernetes_storage_class.test", "allow_volume_expansion", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKubernetesStorageClass_importBasic(t *testing.T) {
 	resourceName := "kubernetes_storage_class.test"
 	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
@@ -289,3 +327,40 @@ resource "kubernetes_storage_class" "test" {
 	storage_provisioner = "kubernetes.io/gce-pd"
 }`, prefix)
 }
+
+func testAccKubernetesStorageClassConfig_topologies(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_storage_class" "test" {
+	metadata {
+		name = "%s"
+	}
+	storage_provisioner = "kubernetes.io/gce-pd"
+	volume_binding_mode = "WaitForFirstConsumer"
+	allowed_topologies {
+		match_label_expressions {
+			key    = "failure-domain.beta.kubernetes.io/zone"
+			values = ["us-west1-a", "us-west1-b"]
+		}
+	}
+	mount_options = ["debug"]
+}`, name)
+}
+
+func testAccKubernetesStorageClassConfig_topologiesModified(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_storage_class" "test" {
+	metadata {
+		name = "%s"
+	}
+	storage_provisioner = "kubernetes.io/gce-pd"
+	volume_binding_mode = "WaitForFirstConsumer"
+	allowed_topologies {
+		match_label_expressions {
+			key    = "failure-domain.beta.kubernetes.io/zone"
+			values = ["us-west1-a", "us-west1-b"]
+		}
+	}
+	mount_options          = ["debug", "noatime"]
+	allow_volume_expansion = true
+}`, name)
+}
@@ -0,0 +1,42 @@
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// deleteOptionsSchema returns the arguments shared by all resources which
+// control how the object is removed when the resource is destroyed.
+func deleteOptionsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"delete_options": {
+			Type:        schema.TypeList,
+			Description: "Options used when deleting the object.",
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"propagation_policy": {
+						Type:         schema.TypeString,
+						Description:  "Whether and how garbage collection is performed for the dependents of the object. One of `Foreground`, `Background` or `Orphan`. Defaults to the resource's own policy.",
+						Optional:     true,
+						ValidateFunc: validateAttributeValueIsIn([]string{"Foreground", "Background", "Orphan"}),
+					},
+					// Defaults to -1 to tell unset apart from zero, defaults
+					// aren't validated
+					"grace_period_seconds": {
+						Type:         schema.TypeInt,
+						Description:  "Duration in seconds before the object is deleted. Zero means delete immediately. Defaults to the default grace period of the object's type.",
+						Optional:     true,
+						Default:      -1,
+						ValidateFunc: validateTerminationGracePeriodSeconds,
+					},
+				},
+			},
+		},
+		"retain_on_destroy": {
+			Type:        schema.TypeBool,
+			Description: "Remove the object from the state without deleting it when the resource is destroyed. Changes which would replace the object are refused.",
+			Optional:    true,
+		},
+	}
+}
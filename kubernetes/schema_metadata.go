@@ -16,6 +16,15 @@ func metadataFields(objectName string) map[string]*schema.Schema {
 			Elem:         &schema.Schema{Type: schema.TypeString},
 			ValidateFunc: validateAnnotations,
 		},
+		"finalizers": {
+			Type:        schema.TypeList,
+			Description: fmt.Sprintf("List of finalizers which must be removed before the %s is deleted from the cluster. Finalizers maintained by Kubernetes itself are ignored unless listed here. More info: https://kubernetes.io/docs/tasks/access-kubernetes-api/custom-resources/custom-resource-definitions/#finalizers", objectName),
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateName,
+			},
+		},
 		"generation": {
 			Type:        schema.TypeInt,
 			Description: "A sequence number representing a specific generation of the desired state.",
@@ -36,6 +45,14 @@ func metadataFields(objectName string) map[string]*schema.Schema {
 			Computed:     true,
 			ValidateFunc: validateName,
 		},
+		"owner_references": {
+			Type:        schema.TypeList,
+			Description: fmt.Sprintf("List of objects the %s depends on. When all of them are deleted, the %s is garbage collected. More info: https://kubernetes.io/docs/concepts/workloads/controllers/garbage-collection/", objectName, objectName),
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: ownerReferenceFields(),
+			},
+		},
 		"resource_version": {
 			Type:        schema.TypeString,
 			Description: fmt.Sprintf("An opaque value that represents the internal version of this %s that can be used by clients to determine when %s has changed. Read more: https://github.com/kubernetes/community/blob/master/contributors/devel/api-conventions.md#concurrency-control-and-consistency", objectName, objectName),
@@ -54,6 +71,41 @@ func metadataFields(objectName string) map[string]*schema.Schema {
 	}
 }
 
+func ownerReferenceFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"api_version": {
+			Type:        schema.TypeString,
+			Description: "API version of the referent.",
+			Required:    true,
+		},
+		"block_owner_deletion": {
+			Type:        schema.TypeBool,
+			Description: "If true, and if the owner has the `foregroundDeletion` finalizer, then the owner cannot be deleted from the key-value store until this reference is removed.",
+			Optional:    true,
+		},
+		"controller": {
+			Type:        schema.TypeBool,
+			Description: "If true, this reference points to the managing controller. At most one owner reference may be a controller.",
+			Optional:    true,
+		},
+		"kind": {
+			Type:        schema.TypeString,
+			Description: "Kind of the referent. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/api-conventions.md#types-kinds",
+			Required:    true,
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "Name of the referent. More info: http://kubernetes.io/docs/user-guide/identifiers#names",
+			Required:    true,
+		},
+		"uid": {
+			Type:        schema.TypeString,
+			Description: "UID of the referent. More info: http://kubernetes.io/docs/user-guide/identifiers#uids",
+			Required:    true,
+		},
+	}
+}
+
 func metadataSchema(objectName string, generatableName bool) *schema.Schema {
 	fields := metadataFields(objectName)
 
@@ -137,14 +189,22 @@ func rbacMetadataSchema(objectName string, generatableName bool) *schema.Schema
 	}
 }
 
+// suppressUnsetNamespace keeps objects in their namespace when it isn't set,
+// rather than moving them to the default namespace of the provider, which
+// only applies to new objects.
+func suppressUnsetNamespace(k, old, new string, d *schema.ResourceData) bool {
+	// Unknown namespaces read as empty too, but aren't suppressed
+	return new == "" && old != "" && d.Get(k).(string) != ""
+}
+
 func namespacedMetadataSchema(objectName string, generatableName bool) *schema.Schema {
 	fields := metadataFields(objectName)
 	fields["namespace"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Description: fmt.Sprintf("Namespace defines the space within which name of the %s must be unique.", objectName),
-		Optional:    true,
-		ForceNew:    true,
-		Default:     "default",
+		Type:             schema.TypeString,
+		Description:      fmt.Sprintf("Namespace defines the space within which name of the %s must be unique. Defaults to the namespace of the provider.", objectName),
+		Optional:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: suppressUnsetNamespace,
 	}
 	if generatableName {
 		fields["generate_name"] = &schema.Schema{
@@ -0,0 +1,74 @@
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hil/ast"
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestNamespacedMetadataSchema_unsetNamespace(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "staging/example",
+		Attributes: map[string]string{
+			"id":                   "staging/example",
+			"metadata.#":           "1",
+			"metadata.0.name":      "example",
+			"metadata.0.namespace": "staging",
+		},
+	}
+
+	testCases := []struct {
+		Namespace       interface{}
+		ExpectedNew     string
+		ExpectedChange  bool
+		ExpectedUnknown bool
+	}{
+		// Objects stay in their namespace when the provider's changes
+		{nil, "", false, false},
+		{"staging", "", false, false},
+		{"production", "production", true, false},
+		{"${var.namespace}", "", true, true},
+	}
+	for _, tc := range testCases {
+		metadata := map[string]interface{}{"name": "example"}
+		if tc.Namespace != nil {
+			metadata["namespace"] = tc.Namespace
+		}
+		raw, err := config.NewRawConfig(map[string]interface{}{
+			"metadata": []interface{}{metadata},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = raw.Interpolate(map[string]ast.Variable{
+			"var.namespace": {Type: ast.TypeUnknown, Value: config.UnknownVariableValue},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		diff, err := resourceKubernetesConfigMap().Diff(state, terraform.NewResourceConfig(raw), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var attr *terraform.ResourceAttrDiff
+		changed := false
+		if diff != nil {
+			attr, changed = diff.GetAttribute("metadata.0.namespace")
+		}
+		if changed != tc.ExpectedChange {
+			t.Fatalf("Expected a change of namespace %v: %t, given %t", tc.Namespace, tc.ExpectedChange, changed)
+		}
+		if !changed {
+			continue
+		}
+		if attr.NewComputed != tc.ExpectedUnknown || !attr.RequiresNew {
+			t.Fatalf("Expected namespace %v to replace the object, unknown: %t, given %#v", tc.Namespace, tc.ExpectedUnknown, attr)
+		}
+		if !tc.ExpectedUnknown && attr.New != tc.ExpectedNew {
+			t.Fatalf("Expected namespace %q, given %q", tc.ExpectedNew, attr.New)
+		}
+	}
+}
@@ -39,7 +39,8 @@ func persistentVolumeClaimSpecFields(pvcTemplate bool) map[string]*schema.Schema
 									Type:        schema.TypeMap,
 									Description: "Map describing the minimum amount of compute resources required. If this is omitted for a container, it defaults to `limits` if that is explicitly specified, otherwise to an implementation-defined value. More info: http://kubernetes.io/docs/user-guide/compute-resources/",
 									Optional:    true,
-									ForceNew:    true,
+									// Claims may be expanded in place, see resourceKubernetesPersistentVolumeClaimCustomizeDiff
+									ForceNew: pvcTemplate,
 								},
 							},
 						},
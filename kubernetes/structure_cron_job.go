@@ -5,7 +5,7 @@ import (
 	batchv1beta1 "k8s.io/api/batch/v1beta1"
 )
 
-func flattenCronJobSpec(in batchv1beta1.CronJobSpec, d *schema.ResourceData) ([]interface{}, error) {
+func flattenCronJobSpec(in batchv1beta1.CronJobSpec, d *schema.ResourceData, settings metadataSettings) ([]interface{}, error) {
 	att := make(map[string]interface{})
 
 	att["concurrency_policy"] = in.ConcurrencyPolicy
@@ -17,7 +17,7 @@ func flattenCronJobSpec(in batchv1beta1.CronJobSpec, d *schema.ResourceData) ([]
 
 	att["schedule"] = in.Schedule
 
-	jobTemplate, err := flattenJobTemplate(in.JobTemplate, d)
+	jobTemplate, err := flattenJobTemplate(in.JobTemplate, d, settings)
 	if err != nil {
 		return nil, err
 	}
@@ -38,13 +38,13 @@ func flattenCronJobSpec(in batchv1beta1.CronJobSpec, d *schema.ResourceData) ([]
 	return []interface{}{att}, nil
 }
 
-func flattenJobTemplate(in batchv1beta1.JobTemplateSpec, d *schema.ResourceData) ([]interface{}, error) {
+func flattenJobTemplate(in batchv1beta1.JobTemplateSpec, d *schema.ResourceData, settings metadataSettings) ([]interface{}, error) {
 	att := make(map[string]interface{})
 
-	meta := flattenMetadata(in.ObjectMeta, d)
+	meta := flattenMetadata(in.ObjectMeta, d, settings)
 	att["metadata"] = meta
 
-	jobSpec, err := flattenJobSpec(in.Spec, d)
+	jobSpec, err := flattenJobSpec(in.Spec, d, settings)
 	if err != nil {
 		return nil, err
 	}
@@ -53,7 +53,7 @@ func flattenJobTemplate(in batchv1beta1.JobTemplateSpec, d *schema.ResourceData)
 	return []interface{}{att}, nil
 }
 
-func expandCronJobSpec(j []interface{}) (batchv1beta1.CronJobSpec, error) {
+func expandCronJobSpec(j []interface{}, settings metadataSettings) (batchv1beta1.CronJobSpec, error) {
 	obj := batchv1beta1.CronJobSpec{}
 
 	if len(j) == 0 || j[0] == nil {
@@ -70,7 +70,7 @@ func expandCronJobSpec(j []interface{}) (batchv1beta1.CronJobSpec, error) {
 
 	obj.Schedule = in["schedule"].(string)
 
-	jtSpec, err := expandJobTemplate(in["job_template"].([]interface{}))
+	jtSpec, err := expandJobTemplate(in["job_template"].([]interface{}), settings)
 	if err != nil {
 		return obj, err
 	}
@@ -91,19 +91,19 @@ func expandCronJobSpec(j []interface{}) (batchv1beta1.CronJobSpec, error) {
 	return obj, nil
 }
 
-func expandJobTemplate(in []interface{}) (batchv1beta1.JobTemplateSpec, error) {
+func expandJobTemplate(in []interface{}, settings metadataSettings) (batchv1beta1.JobTemplateSpec, error) {
 	obj := batchv1beta1.JobTemplateSpec{}
 
 	tpl := in[0].(map[string]interface{})
 
-	spec, err := expandJobSpec(tpl["spec"].([]interface{}))
+	spec, err := expandJobSpec(tpl["spec"].([]interface{}), settings)
 	if err != nil {
 		return obj, err
 	}
 	obj.Spec = spec
 
 	if metaCfg, ok := tpl["metadata"]; ok {
-		metadata := expandMetadata(metaCfg.([]interface{}))
+		metadata := expandMetadata(metaCfg.([]interface{}), settings)
 		obj.ObjectMeta = metadata
 	}
 
@@ -5,7 +5,7 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 )
 
-func flattenJobSpec(in batchv1.JobSpec, d *schema.ResourceData) ([]interface{}, error) {
+func flattenJobSpec(in batchv1.JobSpec, d *schema.ResourceData, settings metadataSettings) ([]interface{}, error) {
 	att := make(map[string]interface{})
 
 	if in.ActiveDeadlineSeconds != nil {
@@ -43,7 +43,7 @@ func flattenJobSpec(in batchv1.JobSpec, d *schema.ResourceData) ([]interface{},
 		delete(labels, "job-name")
 	}
 
-	podSpec, err := flattenPodTemplateSpec(in.Template, d)
+	podSpec, err := flattenPodTemplateSpec(in.Template, d, settings)
 	if err != nil {
 		return nil, err
 	}
@@ -52,7 +52,7 @@ func flattenJobSpec(in batchv1.JobSpec, d *schema.ResourceData) ([]interface{},
 	return []interface{}{att}, nil
 }
 
-func expandJobSpec(j []interface{}) (batchv1.JobSpec, error) {
+func expandJobSpec(j []interface{}, settings metadataSettings) (batchv1.JobSpec, error) {
 	obj := batchv1.JobSpec{}
 
 	if len(j) == 0 || j[0] == nil {
@@ -87,7 +87,7 @@ func expandJobSpec(j []interface{}) (batchv1.JobSpec, error) {
 
 	for _, v := range in["template"].([]interface{}) {
 		template := v.(map[string]interface{})
-		pts, err := expandPodTemplateSpec(template)
+		pts, err := expandPodTemplateSpec(template, settings)
 		if err != nil {
 			return obj, err
 		}
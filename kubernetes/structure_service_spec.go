@@ -58,6 +58,28 @@ func flattenServiceSpec(in v1.ServiceSpec) []interface{} {
 	if in.ExternalName != "" {
 		att["external_name"] = in.ExternalName
 	}
+	if in.ExternalTrafficPolicy != "" {
+		att["external_traffic_policy"] = string(in.ExternalTrafficPolicy)
+	}
+	if in.HealthCheckNodePort != 0 {
+		att["health_check_node_port"] = int(in.HealthCheckNodePort)
+	}
+	att["publish_not_ready_addresses"] = in.PublishNotReadyAddresses
+	if in.SessionAffinityConfig != nil {
+		att["session_affinity_config"] = flattenSessionAffinityConfig(in.SessionAffinityConfig)
+	}
+	return []interface{}{att}
+}
+
+func flattenSessionAffinityConfig(in *v1.SessionAffinityConfig) []interface{} {
+	att := make(map[string]interface{})
+	if in.ClientIP != nil {
+		clientIP := make(map[string]interface{})
+		if in.ClientIP.TimeoutSeconds != nil {
+			clientIP["timeout_seconds"] = int(*in.ClientIP.TimeoutSeconds)
+		}
+		att["client_ip"] = []interface{}{clientIP}
+	}
 	return []interface{}{att}
 }
 
@@ -138,6 +160,42 @@ func expandServiceSpec(l []interface{}) v1.ServiceSpec {
 	if v, ok := in["external_name"].(string); ok {
 		obj.ExternalName = v
 	}
+	if v, ok := in["external_traffic_policy"].(string); ok {
+		obj.ExternalTrafficPolicy = v1.ServiceExternalTrafficPolicyType(v)
+	}
+	// The API server refuses a health check node port for services which
+	// no longer need one, so only pass on what's kept from the state when
+	// it still applies
+	if v, ok := in["health_check_node_port"].(int); ok && obj.Type == v1.ServiceTypeLoadBalancer &&
+		obj.ExternalTrafficPolicy == v1.ServiceExternalTrafficPolicyTypeLocal {
+		obj.HealthCheckNodePort = int32(v)
+	}
+	if v, ok := in["publish_not_ready_addresses"].(bool); ok {
+		obj.PublishNotReadyAddresses = v
+	}
+	// Likewise the session affinity config is refused without ClientIP affinity
+	if v, ok := in["session_affinity_config"].([]interface{}); ok && obj.SessionAffinity == v1.ServiceAffinityClientIP {
+		obj.SessionAffinityConfig = expandSessionAffinityConfig(v)
+	}
+	return obj
+}
+
+func expandSessionAffinityConfig(l []interface{}) *v1.SessionAffinityConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	in := l[0].(map[string]interface{})
+	obj := &v1.SessionAffinityConfig{}
+	if v, ok := in["client_ip"].([]interface{}); ok && len(v) > 0 {
+		obj.ClientIP = &v1.ClientIPConfig{}
+		if v[0] == nil {
+			return obj
+		}
+		clientIP := v[0].(map[string]interface{})
+		if v, ok := clientIP["timeout_seconds"].(int); ok && v > 0 {
+			obj.ClientIP.TimeoutSeconds = ptrToInt32(int32(v))
+		}
+	}
 	return obj
 }
 
@@ -2,9 +2,13 @@ package kubernetes
 
 import (
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"log"
 	"net/url"
+	"reflect"
+	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
@@ -12,6 +16,7 @@ import (
 	api "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 func idParts(id string) (string, string, error) {
@@ -28,7 +33,59 @@ func buildId(meta metav1.ObjectMeta) string {
 	return meta.Namespace + "/" + meta.Name
 }
 
-func expandMetadata(in []interface{}) metav1.ObjectMeta {
+// metadataNamespace returns the namespace set in the metadata of d, falling
+// back to the default namespace of the provider.
+func metadataNamespace(d *schema.ResourceData, settings metadataSettings) string {
+	if v, ok := d.GetOk("metadata.0.namespace"); ok {
+		return v.(string)
+	}
+	return settings.DefaultNamespace
+}
+
+// plannedNamespace returns the namespace of the object planned in diff, and
+// whether it is known yet, which it isn't when interpolated from resources
+// not applied so far.
+func plannedNamespace(diff *schema.ResourceDiff, defaultNamespace string) (string, bool) {
+	namespace := diff.Get("metadata.0.namespace").(string)
+	if namespace != "" {
+		return namespace, true
+	}
+	if len(diff.GetChangedKeysPrefix("metadata.0.namespace")) > 0 {
+		return "", false
+	}
+	return defaultNamespace, true
+}
+
+// metadataSettings holds the provider settings applying to the metadata of
+// every object it manages.
+type metadataSettings struct {
+	// Labels and annotations added to every object
+	DefaultLabels      map[string]string
+	DefaultAnnotations map[string]string
+	// Patterns of the label and annotation keys managed outside of Terraform
+	IgnoredLabels      []*regexp.Regexp
+	IgnoredAnnotations []*regexp.Regexp
+	// Namespace of the namespaced objects which don't set one
+	DefaultNamespace string
+}
+
+// expandMetadata expands the configured metadata merged over the provider
+// default labels and annotations.
+func expandMetadata(in []interface{}, settings metadataSettings) metav1.ObjectMeta {
+	meta := expandConfiguredMetadata(in)
+	meta.Annotations = mergeStringMaps(settings.DefaultAnnotations, meta.Annotations)
+	meta.Labels = mergeStringMaps(settings.DefaultLabels, meta.Labels)
+	if len(in) > 0 {
+		if _, ok := in[0].(map[string]interface{})["namespace"]; ok && meta.Namespace == "" {
+			meta.Namespace = settings.DefaultNamespace
+		}
+	}
+	return meta
+}
+
+// expandConfiguredMetadata expands the metadata as configured, leaving out
+// the provider defaults.
+func expandConfiguredMetadata(in []interface{}) metav1.ObjectMeta {
 	meta := metav1.ObjectMeta{}
 	if len(in) < 1 {
 		return meta
@@ -50,25 +107,222 @@ func expandMetadata(in []interface{}) metav1.ObjectMeta {
 	if v, ok := m["resource_version"]; ok {
 		meta.ResourceVersion = v.(string)
 	}
+	if v, ok := m["owner_references"].([]interface{}); ok && len(v) > 0 {
+		meta.OwnerReferences = expandOwnerReferences(v)
+	}
+	if v, ok := m["finalizers"].([]interface{}); ok && len(v) > 0 {
+		meta.Finalizers = expandStringSlice(v)
+	}
 
 	return meta
 }
 
-func patchMetadata(keyPrefix, pathPrefix string, d *schema.ResourceData) PatchOperations {
+func expandOwnerReferences(l []interface{}) []metav1.OwnerReference {
+	refs := make([]metav1.OwnerReference, 0, len(l))
+	for _, r := range l {
+		m, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		ref := metav1.OwnerReference{
+			APIVersion: m["api_version"].(string),
+			Kind:       m["kind"].(string),
+			Name:       m["name"].(string),
+			UID:        types.UID(m["uid"].(string)),
+		}
+		if v, ok := m["controller"].(bool); ok && v {
+			ref.Controller = ptrToBool(v)
+		}
+		if v, ok := m["block_owner_deletion"].(bool); ok && v {
+			ref.BlockOwnerDeletion = ptrToBool(v)
+		}
+		refs = append(refs, ref)
+	}
+	return refs
+}
+
+func patchMetadata(keyPrefix, pathPrefix string, d *schema.ResourceData, kp *kubernetesProvider) PatchOperations {
+	settings := kp.metadataSettings
+	// The state leaves out the keys managed outside of Terraform, so whether
+	// the maps of the live object are set is only known by reading it. When
+	// that fails, keys are added one by one, which fails the patch rather than
+	// removing them if the map turns out to be missing.
+	var live *metav1.ObjectMeta
+	var liveErr error
+	readLive := func() {
+		if live == nil && liveErr == nil {
+			live, liveErr = kp.readLiveMetadata(d)
+			if liveErr != nil {
+				log.Printf("[WARN] Failed to read the live metadata of %s: %s", d.Id(), liveErr)
+			}
+		}
+	}
+
 	ops := make([]PatchOperation, 0, 0)
 	if d.HasChange(keyPrefix + "annotations") {
 		oldV, newV := d.GetChange(keyPrefix + "annotations")
-		diffOps := diffStringMap(pathPrefix+"annotations", oldV.(map[string]interface{}), newV.(map[string]interface{}))
+		oldM := withDefaults(settings.DefaultAnnotations, oldV.(map[string]interface{}))
+		newM := withDefaults(settings.DefaultAnnotations, newV.(map[string]interface{}))
+		diffOps := diffIgnoredStringMap(pathPrefix+"annotations", oldM, newM, settings.IgnoredAnnotations, func() bool {
+			readLive()
+			return liveErr != nil || len(live.Annotations) > 0
+		})
 		ops = append(ops, diffOps...)
 	}
 	if d.HasChange(keyPrefix + "labels") {
 		oldV, newV := d.GetChange(keyPrefix + "labels")
-		diffOps := diffStringMap(pathPrefix+"labels", oldV.(map[string]interface{}), newV.(map[string]interface{}))
+		oldM := withDefaults(settings.DefaultLabels, oldV.(map[string]interface{}))
+		newM := withDefaults(settings.DefaultLabels, newV.(map[string]interface{}))
+		diffOps := diffIgnoredStringMap(pathPrefix+"labels", oldM, newM, settings.IgnoredLabels, func() bool {
+			readLive()
+			return liveErr != nil || len(live.Labels) > 0
+		})
 		ops = append(ops, diffOps...)
 	}
+	if d.HasChange(keyPrefix + "owner_references") {
+		oldV, newV := d.GetChange(keyPrefix + "owner_references")
+		ops = append(ops, patchList(pathPrefix+"ownerReferences",
+			len(oldV.([]interface{})), expandOwnerReferences(newV.([]interface{}))))
+	}
+	if d.HasChange(keyPrefix + "finalizers") {
+		oldV, newV := d.GetChange(keyPrefix + "finalizers")
+		ops = append(ops, patchList(pathPrefix+"finalizers",
+			len(oldV.([]interface{})), expandStringSlice(newV.([]interface{}))))
+	}
+	return ops
+}
+
+// patchList replaces a whole list, which JSON patch only allows when it
+// already exists, so lists are added or removed when going from or to empty.
+func patchList(path string, oldLen int, newList interface{}) PatchOperation {
+	if oldLen == 0 {
+		return &AddOperation{Path: path, Value: newList}
+	}
+	if reflect.ValueOf(newList).Len() == 0 {
+		return &RemoveOperation{Path: path}
+	}
+	return &ReplaceOperation{Path: path, Value: newList}
+}
+
+// diffIgnoredStringMap diffs a map of the object for a patch which never
+// removes the ignored keys. As those are left out of the state, an empty old
+// map doesn't mean the live one is, so keys are only added one by one unless
+// liveMapSet tells it's missing.
+func diffIgnoredStringMap(pathPrefix string, oldV, newV map[string]interface{}, ignored []*regexp.Regexp, liveMapSet func() bool) PatchOperations {
+	newV = keepIgnoredKeys(oldV, newV, ignored)
+	if len(oldV) > 0 || len(ignored) == 0 {
+		return diffStringMap(pathPrefix, oldV, newV)
+	}
+	ops := make([]PatchOperation, 0, len(newV))
+	if len(newV) == 0 {
+		return ops
+	}
+	if !liveMapSet() {
+		return diffStringMap(pathPrefix, oldV, newV)
+	}
+	for k, v := range newV {
+		ops = append(ops, &AddOperation{
+			Path:  strings.TrimRight(pathPrefix, "/") + "/" + escapeJsonPointer(k),
+			Value: v.(string),
+		})
+	}
 	return ops
 }
 
+// keepIgnoredStringMap adds the ignored keys of the live map to a new copy of
+// m, for updates replacing the whole object.
+func keepIgnoredStringMap(live, m map[string]string, ignored []*regexp.Regexp) map[string]string {
+	result := make(map[string]string, len(m))
+	for k, v := range live {
+		if isIgnoredKey(k, ignored) {
+			result[k] = v
+		}
+	}
+	for k, v := range m {
+		result[k] = v
+	}
+	if len(result) == 0 {
+		return m
+	}
+	return result
+}
+
+// keepIgnoredMetadata adds the labels and annotations managed outside of
+// Terraform to the metadata of updates replacing the whole object.
+func keepIgnoredMetadata(d *schema.ResourceData, kp *kubernetesProvider, metadata *metav1.ObjectMeta) error {
+	settings := kp.metadataSettings
+	if len(settings.IgnoredAnnotations) == 0 && len(settings.IgnoredLabels) == 0 {
+		return nil
+	}
+	live, err := kp.readLiveMetadata(d)
+	if err != nil {
+		return fmt.Errorf("Failed to read the live metadata of %s: %s", d.Id(), err)
+	}
+	metadata.Annotations = keepIgnoredStringMap(live.Annotations, metadata.Annotations, settings.IgnoredAnnotations)
+	metadata.Labels = keepIgnoredStringMap(live.Labels, metadata.Labels, settings.IgnoredLabels)
+	return nil
+}
+
+// readLiveMetadata reads the metadata of the object of d from its self link,
+// including the keys left out of the state.
+func (p *kubernetesProvider) readLiveMetadata(d *schema.ResourceData) (*metav1.ObjectMeta, error) {
+	selfLink := d.Get("metadata.0.self_link").(string)
+	if selfLink == "" {
+		return nil, fmt.Errorf("no self link known")
+	}
+	raw, err := p.conn.CoreV1().RESTClient().Get().AbsPath(selfLink).DoRaw()
+	if err != nil {
+		return nil, err
+	}
+	obj := struct {
+		Metadata metav1.ObjectMeta `json:"metadata"`
+	}{}
+	err = json.Unmarshal(raw, &obj)
+	if err != nil {
+		return nil, err
+	}
+	return &obj.Metadata, nil
+}
+
+// keepIgnoredKeys adds the ignored keys about to be removed back to the new
+// map, so that patches never remove keys managed outside of Terraform.
+func keepIgnoredKeys(oldV, newV map[string]interface{}, ignored []*regexp.Regexp) map[string]interface{} {
+	for k, v := range oldV {
+		if _, ok := newV[k]; !ok && isIgnoredKey(k, ignored) {
+			log.Printf("[DEBUG] Keeping ignored key %s", k)
+			newV[k] = v
+		}
+	}
+	return newV
+}
+
+// mergeStringMaps returns the defaults overridden by the given values.
+func mergeStringMaps(defaults, m map[string]string) map[string]string {
+	if len(defaults) == 0 {
+		return m
+	}
+	result := make(map[string]string, len(defaults)+len(m))
+	for k, v := range defaults {
+		result[k] = v
+	}
+	for k, v := range m {
+		result[k] = v
+	}
+	return result
+}
+
+// withDefaults is mergeStringMaps for maps read from the configuration.
+func withDefaults(defaults map[string]string, m map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(defaults)+len(m))
+	for k, v := range defaults {
+		result[k] = v
+	}
+	for k, v := range m {
+		result[k] = v
+	}
+	return result
+}
+
 func expandStringMap(m map[string]interface{}) map[string]string {
 	result := make(map[string]string)
 	for k, v := range m {
@@ -85,6 +339,20 @@ func expandStringMapToByteMap(m map[string]interface{}) map[string][]byte {
 	return result
 }
 
+func expandBase64MapToByteMap(m map[string]interface{}) map[string][]byte {
+	result := make(map[string][]byte)
+	for k, v := range m {
+		// Values are checked by validateBase64EncodedMap at plan time
+		b, err := base64.StdEncoding.DecodeString(v.(string))
+		if err != nil {
+			log.Printf("[WARN] Skipping %q, value is not valid base64: %s", k, err)
+			continue
+		}
+		result[k] = b
+	}
+	return result
+}
+
 func expandStringSlice(s []interface{}) []string {
 	result := make([]string, len(s), len(s))
 	for k, v := range s {
@@ -97,20 +365,37 @@ func expandStringSlice(s []interface{}) []string {
 	return result
 }
 
-func flattenMetadata(meta metav1.ObjectMeta, d *schema.ResourceData) []map[string]interface{} {
+func expandRegexps(l []interface{}) ([]*regexp.Regexp, error) {
+	result := make([]*regexp.Regexp, 0, len(l))
+	for _, v := range l {
+		r, err := regexp.Compile(v.(string))
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, r)
+	}
+	return result, nil
+}
+
+func flattenMetadata(meta metav1.ObjectMeta, d *schema.ResourceData, settings metadataSettings) []map[string]interface{} {
 	m := make(map[string]interface{})
 	configAnnotations := d.Get("metadata.0.annotations").(map[string]interface{})
-	m["annotations"] = removeInternalKeys(meta.Annotations, configAnnotations)
+	annotations := removeInternalKeys(meta.Annotations, configAnnotations, settings.IgnoredAnnotations)
+	m["annotations"] = removeDefaultKeys(annotations, settings.DefaultAnnotations, configAnnotations)
 	if meta.GenerateName != "" {
 		m["generate_name"] = meta.GenerateName
 	}
 	configLabels := d.Get("metadata.0.labels").(map[string]interface{})
-	m["labels"] = removeInternalKeys(meta.Labels, configLabels)
+	labels := removeInternalKeys(meta.Labels, configLabels, settings.IgnoredLabels)
+	m["labels"] = removeDefaultKeys(labels, settings.DefaultLabels, configLabels)
 	m["name"] = meta.Name
 	m["resource_version"] = meta.ResourceVersion
 	m["self_link"] = meta.SelfLink
 	m["uid"] = fmt.Sprintf("%v", meta.UID)
 	m["generation"] = meta.Generation
+	m["owner_references"] = flattenOwnerReferences(meta.OwnerReferences)
+	configFinalizers, _ := d.Get("metadata.0.finalizers").([]interface{})
+	m["finalizers"] = removeInternalFinalizers(meta.Finalizers, configFinalizers)
 
 	if meta.Namespace != "" {
 		m["namespace"] = meta.Namespace
@@ -119,11 +404,61 @@ func flattenMetadata(meta metav1.ObjectMeta, d *schema.ResourceData) []map[strin
 	return []map[string]interface{}{m}
 }
 
-func flattenSubMetadata(meta metav1.ObjectMeta, d *schema.ResourceData, prefix string) []map[string]interface{} {
+func flattenOwnerReferences(in []metav1.OwnerReference) []interface{} {
+	att := make([]interface{}, len(in), len(in))
+	for i, r := range in {
+		m := map[string]interface{}{
+			"api_version": r.APIVersion,
+			"kind":        r.Kind,
+			"name":        r.Name,
+			"uid":         string(r.UID),
+		}
+		if r.Controller != nil {
+			m["controller"] = *r.Controller
+		}
+		if r.BlockOwnerDeletion != nil {
+			m["block_owner_deletion"] = *r.BlockOwnerDeletion
+		}
+		att[i] = m
+	}
+	return att
+}
+
+// removeInternalFinalizers drops the finalizers maintained by Kubernetes
+// itself, such as the protection of volumes in use, unless they're configured.
+func removeInternalFinalizers(in []string, d []interface{}) []string {
+	out := make([]string, 0, len(in))
+	for _, f := range in {
+		if isInternalFinalizer(f) && !isValueInList(f, d) {
+			continue
+		}
+		out = append(out, f)
+	}
+	return out
+}
+
+func isInternalFinalizer(f string) bool {
+	switch f {
+	case metav1.FinalizerOrphanDependents, metav1.FinalizerDeleteDependents:
+		return true
+	}
+	return isInternalKey(f)
+}
+
+func isValueInList(v string, l []interface{}) bool {
+	for _, e := range l {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
+
+func flattenSubMetadata(meta metav1.ObjectMeta, d *schema.ResourceData, prefix string, settings metadataSettings) []map[string]interface{} {
 	m := make(map[string]interface{})
 
 	configAnnotations := d.Get(prefix + ".metadata.0.annotations").(map[string]interface{})
-	m["annotations"] = removeInternalKeys(meta.Annotations, configAnnotations)
+	m["annotations"] = removeInternalKeys(meta.Annotations, configAnnotations, settings.IgnoredAnnotations)
 	m["labels"] = meta.Labels
 	m["name"] = meta.Name
 	m["resource_version"] = meta.ResourceVersion
@@ -138,11 +473,13 @@ func flattenSubMetadata(meta metav1.ObjectMeta, d *schema.ResourceData, prefix s
 	return []map[string]interface{}{m}
 }
 
-func removeInternalKeys(m map[string]string, d map[string]interface{}) map[string]string {
+// removeInternalKeys drops the keys managed by Kubernetes itself or matching
+// one of the ignored patterns, unless they're configured.
+func removeInternalKeys(m map[string]string, d map[string]interface{}, ignored []*regexp.Regexp) map[string]string {
 	copied, _ := copystructure.Copy(m)
 	newMap := copied.(map[string]string)
 	for k, _ := range m {
-		if isInternalKey(k) && !isKeyInMap(k, d) {
+		if (isInternalKey(k) || isIgnoredKey(k, ignored)) && !isKeyInMap(k, d) {
 			log.Printf("[DEBUG] removing %s", k)
 			delete(newMap, k)
 		}
@@ -162,6 +499,31 @@ func isKeyInMap(key string, d map[string]interface{}) bool {
 	return false
 }
 
+func isIgnoredKey(key string, ignored []*regexp.Regexp) bool {
+	for _, r := range ignored {
+		if r.MatchString(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// removeDefaultKeys drops the keys set to their provider default, unless
+// they're configured, so that provider defaults don't show up in diffs.
+func removeDefaultKeys(m map[string]string, defaults map[string]string, d map[string]interface{}) map[string]string {
+	if len(defaults) == 0 {
+		return m
+	}
+	newMap := make(map[string]string, len(m))
+	for k, v := range m {
+		if dv, ok := defaults[k]; ok && dv == v && !isKeyInMap(k, d) {
+			continue
+		}
+		newMap[k] = v
+	}
+	return newMap
+}
+
 func isInternalKey(annotationKey string) bool {
 	u, err := url.Parse("//" + annotationKey)
 	if err == nil && strings.Contains(u.Hostname(), "kubernetes.io") {
@@ -169,6 +531,8 @@ func isInternalKey(annotationKey string) bool {
 		return true
 	} else if strings.Contains(annotationKey, "deprecated.daemonset.template.generation") {
 		return true
+	} else if annotationKey == configChecksumAnnotation {
+		return true
 	}
 
 	return false
@@ -182,6 +546,14 @@ func byteMapToStringMap(m map[string][]byte) map[string]string {
 	return result
 }
 
+func base64EncodeByteMap(m map[string][]byte) map[string]string {
+	result := make(map[string]string)
+	for k, v := range m {
+		result[k] = base64.StdEncoding.EncodeToString(v)
+	}
+	return result
+}
+
 func ptrToString(s string) *string {
 	return &s
 }
@@ -219,6 +591,35 @@ func base64EncodeStringMap(m map[string]interface{}) map[string]interface{} {
 	return result
 }
 
+// base64NormalizeStringMap re-encodes base64 values in their canonical form,
+// so that they can be compared against what the API returns.
+func base64NormalizeStringMap(m map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	for k, v := range base64EncodeByteMap(expandBase64MapToByteMap(m)) {
+		result[k] = v
+	}
+	return result
+}
+
+// customizeDiffBinaryDataOverlap refuses keys set in both data and
+// binary_data, which share one key space in config maps and secrets.
+func customizeDiffBinaryDataOverlap(diff *schema.ResourceDiff, meta interface{}) error {
+	data := diff.Get("data").(map[string]interface{})
+	binaryData := diff.Get("binary_data").(map[string]interface{})
+
+	keys := make([]string, 0, len(binaryData))
+	for k := range binaryData {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if _, ok := data[k]; ok {
+			return fmt.Errorf("binary_data.%s: key is also set in data", k)
+		}
+	}
+	return nil
+}
+
 func flattenResourceList(l api.ResourceList) map[string]string {
 	m := make(map[string]string)
 	for k, v := range l {
@@ -267,19 +668,20 @@ func expandPersistentVolumeAccessModes(s []interface{}) []api.PersistentVolumeAc
 	return out
 }
 
-func flattenResourceQuotaSpec(in api.ResourceQuotaSpec) []interface{} {
+func flattenResourceQuotaSpec(in resourceQuotaSpec) []interface{} {
 	out := make([]interface{}, 1)
 
 	m := make(map[string]interface{}, 0)
 	m["hard"] = flattenResourceList(in.Hard)
 	m["scopes"] = flattenResourceQuotaScopes(in.Scopes)
+	m["scope_selector"] = flattenResourceQuotaScopeSelector(in.ScopeSelector)
 
 	out[0] = m
 	return out
 }
 
-func expandResourceQuotaSpec(s []interface{}) (api.ResourceQuotaSpec, error) {
-	out := api.ResourceQuotaSpec{}
+func expandResourceQuotaSpec(s []interface{}) (resourceQuotaSpec, error) {
+	out := resourceQuotaSpec{}
 	if len(s) < 1 {
 		return out, nil
 	}
@@ -297,6 +699,10 @@ func expandResourceQuotaSpec(s []interface{}) (api.ResourceQuotaSpec, error) {
 		out.Scopes = expandResourceQuotaScopes(v.(*schema.Set).List())
 	}
 
+	if v, ok := m["scope_selector"]; ok {
+		out.ScopeSelector = expandResourceQuotaScopeSelector(v.([]interface{}))
+	}
+
 	return out, nil
 }
 
@@ -537,3 +943,28 @@ func expandServiceAccountSecrets(in []interface{}, defaultSecretName string) []a
 
 	return att
 }
+
+// expandDeleteOptions returns the delete options configured for the
+// resource, falling back to the given propagation policy when none is set.
+func expandDeleteOptions(in []interface{}, defaultPolicy metav1.DeletionPropagation) *metav1.DeleteOptions {
+	opts := &metav1.DeleteOptions{}
+	if defaultPolicy != "" {
+		opts.PropagationPolicy = &defaultPolicy
+	}
+	if len(in) == 0 || in[0] == nil {
+		return opts
+	}
+	m := in[0].(map[string]interface{})
+	if v, ok := m["propagation_policy"].(string); ok && v != "" {
+		policy := metav1.DeletionPropagation(v)
+		opts.PropagationPolicy = &policy
+	}
+	if v, ok := m["grace_period_seconds"].(int); ok && v >= 0 {
+		opts.GracePeriodSeconds = ptrToInt64(int64(v))
+	}
+	return opts
+}
+
+func isOrphaning(opts *metav1.DeleteOptions) bool {
+	return opts.PropagationPolicy != nil && *opts.PropagationPolicy == metav1.DeletePropagationOrphan
+}
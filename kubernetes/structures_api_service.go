@@ -0,0 +1,91 @@
+package kubernetes
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// apiregistration.k8s.io isn't vendored, so the parts of its v1beta1
+// APIService managed here are mirrored below.
+
+type apiService struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   apiServiceSpec   `json:"spec"`
+	Status apiServiceStatus `json:"status,omitempty"`
+}
+
+type apiServiceSpec struct {
+	Service               *apiServiceServiceReference `json:"service"`
+	Group                 string                      `json:"group,omitempty"`
+	Version               string                      `json:"version,omitempty"`
+	InsecureSkipTLSVerify bool                        `json:"insecureSkipTLSVerify,omitempty"`
+	CABundle              []byte                      `json:"caBundle,omitempty"`
+	GroupPriorityMinimum  int32                       `json:"groupPriorityMinimum"`
+	VersionPriority       int32                       `json:"versionPriority"`
+}
+
+type apiServiceServiceReference struct {
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name,omitempty"`
+}
+
+type apiServiceStatus struct {
+	Conditions []apiServiceCondition `json:"conditions,omitempty"`
+}
+
+type apiServiceCondition struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// Flatteners
+
+func flattenAPIServiceSpec(in apiServiceSpec) []interface{} {
+	att := make(map[string]interface{})
+	att["group"] = in.Group
+	att["version"] = in.Version
+	att["insecure_skip_tls_verify"] = in.InsecureSkipTLSVerify
+	att["ca_bundle"] = string(in.CABundle)
+	att["group_priority_minimum"] = int(in.GroupPriorityMinimum)
+	att["version_priority"] = int(in.VersionPriority)
+	if in.Service != nil {
+		att["service"] = []interface{}{
+			map[string]interface{}{
+				"namespace": in.Service.Namespace,
+				"name":      in.Service.Name,
+			},
+		}
+	}
+	return []interface{}{att}
+}
+
+// Expanders
+
+func expandAPIServiceSpec(l []interface{}) apiServiceSpec {
+	obj := apiServiceSpec{}
+	if len(l) == 0 || l[0] == nil {
+		return obj
+	}
+	in := l[0].(map[string]interface{})
+	obj.Group = in["group"].(string)
+	obj.Version = in["version"].(string)
+	obj.InsecureSkipTLSVerify = in["insecure_skip_tls_verify"].(bool)
+	if v := in["ca_bundle"].(string); v != "" {
+		obj.CABundle = []byte(v)
+	}
+	obj.GroupPriorityMinimum = int32(in["group_priority_minimum"].(int))
+	obj.VersionPriority = int32(in["version_priority"].(int))
+
+	if v, ok := in["service"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		svc := v[0].(map[string]interface{})
+		obj.Service = &apiServiceServiceReference{
+			Namespace: svc["namespace"].(string),
+			Name:      svc["name"].(string),
+		}
+	}
+
+	return obj
+}
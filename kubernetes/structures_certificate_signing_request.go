@@ -0,0 +1,36 @@
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	api "k8s.io/api/certificates/v1beta1"
+)
+
+// Flatteners
+
+func flattenCertificateSigningRequestSpec(in api.CertificateSigningRequestSpec) []interface{} {
+	att := make(map[string]interface{})
+	att["request"] = string(in.Request)
+	usages := make([]string, len(in.Usages), len(in.Usages))
+	for i, u := range in.Usages {
+		usages[i] = string(u)
+	}
+	att["usages"] = newStringSet(schema.HashString, usages)
+	return []interface{}{att}
+}
+
+// Expanders
+
+func expandCertificateSigningRequestSpec(l []interface{}) api.CertificateSigningRequestSpec {
+	obj := api.CertificateSigningRequestSpec{}
+	if len(l) == 0 || l[0] == nil {
+		return obj
+	}
+	in := l[0].(map[string]interface{})
+	obj.Request = []byte(in["request"].(string))
+	if v, ok := in["usages"].(*schema.Set); ok {
+		for _, u := range schemaSetToStringArray(v) {
+			obj.Usages = append(obj.Usages, api.KeyUsage(u))
+		}
+	}
+	return obj
+}
@@ -0,0 +1,221 @@
+package kubernetes
+
+import (
+	"encoding/json"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// apiextensions.k8s.io isn't vendored, so the parts of its v1beta1
+// CustomResourceDefinition managed here are mirrored below.
+
+type customResourceDefinition struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   customResourceDefinitionSpec   `json:"spec"`
+	Status customResourceDefinitionStatus `json:"status,omitempty"`
+}
+
+type customResourceDefinitionSpec struct {
+	Group                    string                            `json:"group"`
+	Version                  string                            `json:"version,omitempty"`
+	Names                    customResourceDefinitionNames     `json:"names"`
+	Scope                    string                            `json:"scope"`
+	Validation               *customResourceValidation         `json:"validation,omitempty"`
+	Subresources             *customResourceSubresources       `json:"subresources,omitempty"`
+	Versions                 []customResourceDefinitionVersion `json:"versions,omitempty"`
+	AdditionalPrinterColumns []customResourceColumnDefinition  `json:"additionalPrinterColumns,omitempty"`
+}
+
+type customResourceDefinitionNames struct {
+	Plural     string   `json:"plural"`
+	Singular   string   `json:"singular,omitempty"`
+	ShortNames []string `json:"shortNames,omitempty"`
+	Kind       string   `json:"kind"`
+	ListKind   string   `json:"listKind,omitempty"`
+	Categories []string `json:"categories,omitempty"`
+}
+
+type customResourceValidation struct {
+	OpenAPIV3Schema json.RawMessage `json:"openAPIV3Schema,omitempty"`
+}
+
+type customResourceSubresources struct {
+	Status *struct{}                       `json:"status,omitempty"`
+	Scale  *customResourceSubresourceScale `json:"scale,omitempty"`
+}
+
+type customResourceSubresourceScale struct {
+	SpecReplicasPath   string  `json:"specReplicasPath"`
+	StatusReplicasPath string  `json:"statusReplicasPath"`
+	LabelSelectorPath  *string `json:"labelSelectorPath,omitempty"`
+}
+
+type customResourceDefinitionVersion struct {
+	Name    string `json:"name"`
+	Served  bool   `json:"served"`
+	Storage bool   `json:"storage"`
+}
+
+type customResourceColumnDefinition struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Format      string `json:"format,omitempty"`
+	Description string `json:"description,omitempty"`
+	Priority    int32  `json:"priority,omitempty"`
+	JSONPath    string `json:"JSONPath"`
+}
+
+type customResourceDefinitionStatus struct {
+	Conditions []customResourceDefinitionCondition `json:"conditions,omitempty"`
+}
+
+type customResourceDefinitionCondition struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// Flatteners
+
+func flattenCustomResourceDefinitionSpec(in customResourceDefinitionSpec) []interface{} {
+	att := make(map[string]interface{})
+	att["group"] = in.Group
+	att["scope"] = in.Scope
+
+	names := make(map[string]interface{})
+	names["plural"] = in.Names.Plural
+	names["singular"] = in.Names.Singular
+	names["kind"] = in.Names.Kind
+	names["list_kind"] = in.Names.ListKind
+	names["short_names"] = in.Names.ShortNames
+	names["categories"] = in.Names.Categories
+	att["names"] = []interface{}{names}
+
+	versions := in.Versions
+	if len(versions) == 0 && in.Version != "" {
+		versions = []customResourceDefinitionVersion{{Name: in.Version, Served: true, Storage: true}}
+	}
+	v := make([]interface{}, len(versions), len(versions))
+	for i, version := range versions {
+		v[i] = map[string]interface{}{
+			"name":    version.Name,
+			"served":  version.Served,
+			"storage": version.Storage,
+		}
+	}
+	att["version"] = v
+
+	if in.Validation != nil && len(in.Validation.OpenAPIV3Schema) > 0 {
+		att["validation"] = string(in.Validation.OpenAPIV3Schema)
+	}
+
+	if in.Subresources != nil {
+		sub := make(map[string]interface{})
+		sub["status"] = in.Subresources.Status != nil
+		if in.Subresources.Scale != nil {
+			scale := map[string]interface{}{
+				"spec_replicas_path":   in.Subresources.Scale.SpecReplicasPath,
+				"status_replicas_path": in.Subresources.Scale.StatusReplicasPath,
+			}
+			if in.Subresources.Scale.LabelSelectorPath != nil {
+				scale["label_selector_path"] = *in.Subresources.Scale.LabelSelectorPath
+			}
+			sub["scale"] = []interface{}{scale}
+		}
+		att["subresources"] = []interface{}{sub}
+	}
+
+	columns := make([]interface{}, len(in.AdditionalPrinterColumns), len(in.AdditionalPrinterColumns))
+	for i, c := range in.AdditionalPrinterColumns {
+		columns[i] = map[string]interface{}{
+			"name":        c.Name,
+			"type":        c.Type,
+			"format":      c.Format,
+			"description": c.Description,
+			"priority":    int(c.Priority),
+			"json_path":   c.JSONPath,
+		}
+	}
+	att["additional_printer_column"] = columns
+
+	return []interface{}{att}
+}
+
+// Expanders
+
+func expandCustomResourceDefinitionSpec(l []interface{}) customResourceDefinitionSpec {
+	obj := customResourceDefinitionSpec{}
+	if len(l) == 0 || l[0] == nil {
+		return obj
+	}
+	in := l[0].(map[string]interface{})
+	obj.Group = in["group"].(string)
+	obj.Scope = in["scope"].(string)
+
+	if v, ok := in["names"].([]interface{}); ok && len(v) > 0 {
+		names := v[0].(map[string]interface{})
+		obj.Names = customResourceDefinitionNames{
+			Plural:     names["plural"].(string),
+			Singular:   names["singular"].(string),
+			Kind:       names["kind"].(string),
+			ListKind:   names["list_kind"].(string),
+			ShortNames: expandStringSlice(names["short_names"].([]interface{})),
+			Categories: expandStringSlice(names["categories"].([]interface{})),
+		}
+	}
+
+	for _, v := range in["version"].([]interface{}) {
+		version := v.(map[string]interface{})
+		obj.Versions = append(obj.Versions, customResourceDefinitionVersion{
+			Name:    version["name"].(string),
+			Served:  version["served"].(bool),
+			Storage: version["storage"].(bool),
+		})
+	}
+	// Clusters predating spec.versions only know spec.version,
+	// which newer ones require to match the first entry.
+	if len(obj.Versions) > 0 {
+		obj.Version = obj.Versions[0].Name
+	}
+
+	if v, ok := in["validation"].(string); ok && v != "" {
+		obj.Validation = &customResourceValidation{
+			OpenAPIV3Schema: json.RawMessage(v),
+		}
+	}
+
+	if v, ok := in["subresources"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		sub := v[0].(map[string]interface{})
+		obj.Subresources = &customResourceSubresources{}
+		if sub["status"].(bool) {
+			obj.Subresources.Status = &struct{}{}
+		}
+		if s, ok := sub["scale"].([]interface{}); ok && len(s) > 0 && s[0] != nil {
+			scale := s[0].(map[string]interface{})
+			obj.Subresources.Scale = &customResourceSubresourceScale{
+				SpecReplicasPath:   scale["spec_replicas_path"].(string),
+				StatusReplicasPath: scale["status_replicas_path"].(string),
+			}
+			if p := scale["label_selector_path"].(string); p != "" {
+				obj.Subresources.Scale.LabelSelectorPath = ptrToString(p)
+			}
+		}
+	}
+
+	for _, v := range in["additional_printer_column"].([]interface{}) {
+		c := v.(map[string]interface{})
+		obj.AdditionalPrinterColumns = append(obj.AdditionalPrinterColumns, customResourceColumnDefinition{
+			Name:        c["name"].(string),
+			Type:        c["type"].(string),
+			Format:      c["format"].(string),
+			Description: c["description"].(string),
+			Priority:    int32(c["priority"].(int)),
+			JSONPath:    c["json_path"].(string),
+		})
+	}
+
+	return obj
+}
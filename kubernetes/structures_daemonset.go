@@ -10,7 +10,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
-func flattenDaemonSetSpec(in appsv1.DaemonSetSpec, d *schema.ResourceData) ([]interface{}, error) {
+func flattenDaemonSetSpec(in appsv1.DaemonSetSpec, d *schema.ResourceData, settings metadataSettings) ([]interface{}, error) {
 	att := make(map[string]interface{})
 	att["min_ready_seconds"] = in.MinReadySeconds
 
@@ -22,7 +22,7 @@ func flattenDaemonSetSpec(in appsv1.DaemonSetSpec, d *schema.ResourceData) ([]in
 	// }
 	// att["template"] = podSpec
 
-	templateMetadata := flattenMetadata(in.Template.ObjectMeta, d)
+	templateMetadata := flattenMetadata(in.Template.ObjectMeta, d, settings)
 	podSpec, err := flattenPodSpec(in.Template.Spec)
 	if err != nil {
 		return nil, err
@@ -54,7 +54,7 @@ func flattenDaemonSetStrategyRollingUpdate(in *appsv1.RollingUpdateDaemonSet) []
 	return []interface{}{att}
 }
 
-func expandDaemonSetSpec(deployment []interface{}) (appsv1.DaemonSetSpec, error) {
+func expandDaemonSetSpec(deployment []interface{}, settings metadataSettings) (appsv1.DaemonSetSpec, error) {
 	obj := appsv1.DaemonSetSpec{}
 	if len(deployment) == 0 || deployment[0] == nil {
 		return obj, nil
@@ -79,7 +79,7 @@ func expandDaemonSetSpec(deployment []interface{}) (appsv1.DaemonSetSpec, error)
 		}
 
 		if metaCfg, ok := template["metadata"]; ok {
-			metadata := expandMetadata(metaCfg.([]interface{}))
+			metadata := expandMetadata(metaCfg.([]interface{}), settings)
 			obj.Template.ObjectMeta = metadata
 		}
 	}
@@ -9,7 +9,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
-func flattenDeploymentSpec(in appsv1.DeploymentSpec, d *schema.ResourceData) ([]interface{}, error) {
+func flattenDeploymentSpec(in appsv1.DeploymentSpec, d *schema.ResourceData, settings metadataSettings) ([]interface{}, error) {
 	att := make(map[string]interface{})
 
 	att["min_ready_seconds"] = in.MinReadySeconds
@@ -35,7 +35,7 @@ func flattenDeploymentSpec(in appsv1.DeploymentSpec, d *schema.ResourceData) ([]
 	att["selector"] = in.Selector.MatchLabels
 	att["strategy"] = flattenDeploymentStrategy(in.Strategy)
 
-	templateMetadata := flattenMetadata(in.Template.ObjectMeta, d)
+	templateMetadata := flattenMetadata(in.Template.ObjectMeta, d, settings)
 	podSpec, err := flattenPodSpec(in.Template.Spec)
 	if err != nil {
 		return nil, err
@@ -71,7 +71,7 @@ func flattenDeploymentStrategyRollingUpdate(in *appsv1.RollingUpdateDeployment)
 	return []interface{}{att}
 }
 
-func expandDeploymentSpec(deployment []interface{}) (appsv1.DeploymentSpec, error) {
+func expandDeploymentSpec(deployment []interface{}, settings metadataSettings) (appsv1.DeploymentSpec, error) {
 	obj := appsv1.DeploymentSpec{}
 	if len(deployment) == 0 || deployment[0] == nil {
 		return obj, nil
@@ -96,7 +96,7 @@ func expandDeploymentSpec(deployment []interface{}) (appsv1.DeploymentSpec, erro
 
 	for _, v := range in["template"].([]interface{}) {
 		template := v.(map[string]interface{})
-		pts, err := expandPodTemplateSpec(template)
+		pts, err := expandPodTemplateSpec(template, settings)
 		if err != nil {
 			return obj, err
 		}
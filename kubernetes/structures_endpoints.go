@@ -0,0 +1,133 @@
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	api "k8s.io/api/core/v1"
+)
+
+// Flatteners
+
+func flattenEndpointsSubsets(in []api.EndpointSubset) *schema.Set {
+	att := make([]interface{}, len(in), len(in))
+	for i, n := range in {
+		m := make(map[string]interface{})
+		m["address"] = flattenEndpointsAddresses(n.Addresses)
+		m["not_ready_address"] = flattenEndpointsAddresses(n.NotReadyAddresses)
+		m["port"] = flattenEndpointsPorts(n.Ports)
+		att[i] = m
+	}
+	return schema.NewSet(hashEndpointsSubset(), att)
+}
+
+func flattenEndpointsAddresses(in []api.EndpointAddress) *schema.Set {
+	att := make([]interface{}, len(in), len(in))
+	for i, n := range in {
+		m := make(map[string]interface{})
+		m["ip"] = n.IP
+		m["hostname"] = n.Hostname
+		m["node_name"] = ""
+		if n.NodeName != nil {
+			m["node_name"] = *n.NodeName
+		}
+		att[i] = m
+	}
+	return schema.NewSet(hashEndpointsSubsetAddress(), att)
+}
+
+func flattenEndpointsPorts(in []api.EndpointPort) *schema.Set {
+	att := make([]interface{}, len(in), len(in))
+	for i, n := range in {
+		m := make(map[string]interface{})
+		m["name"] = n.Name
+		m["port"] = int(n.Port)
+		m["protocol"] = string(n.Protocol)
+		att[i] = m
+	}
+	return schema.NewSet(hashEndpointsSubsetPort(), att)
+}
+
+// Expanders
+
+func expandEndpointsSubsets(in *schema.Set) []api.EndpointSubset {
+	if in == nil || in.Len() == 0 {
+		return []api.EndpointSubset{}
+	}
+	subsets := make([]api.EndpointSubset, in.Len(), in.Len())
+	for i, subset := range in.List() {
+		r := api.EndpointSubset{}
+		subsetCfg := subset.(map[string]interface{})
+		if v, ok := subsetCfg["address"].(*schema.Set); ok {
+			r.Addresses = expandEndpointsAddresses(v)
+		}
+		if v, ok := subsetCfg["not_ready_address"].(*schema.Set); ok {
+			r.NotReadyAddresses = expandEndpointsAddresses(v)
+		}
+		if v, ok := subsetCfg["port"].(*schema.Set); ok {
+			r.Ports = expandEndpointsPorts(v)
+		}
+		subsets[i] = r
+	}
+	return subsets
+}
+
+func expandEndpointsAddresses(in *schema.Set) []api.EndpointAddress {
+	if in == nil || in.Len() == 0 {
+		return nil
+	}
+	addresses := make([]api.EndpointAddress, in.Len(), in.Len())
+	for i, address := range in.List() {
+		r := api.EndpointAddress{}
+		addressCfg := address.(map[string]interface{})
+		if v, ok := addressCfg["ip"]; ok {
+			r.IP = v.(string)
+		}
+		if v, ok := addressCfg["hostname"]; ok {
+			r.Hostname = v.(string)
+		}
+		if v, ok := addressCfg["node_name"]; ok && v.(string) != "" {
+			r.NodeName = ptrToString(v.(string))
+		}
+		addresses[i] = r
+	}
+	return addresses
+}
+
+func expandEndpointsPorts(in *schema.Set) []api.EndpointPort {
+	if in == nil || in.Len() == 0 {
+		return nil
+	}
+	ports := make([]api.EndpointPort, in.Len(), in.Len())
+	for i, port := range in.List() {
+		r := api.EndpointPort{}
+		portCfg := port.(map[string]interface{})
+		if v, ok := portCfg["name"]; ok {
+			r.Name = v.(string)
+		}
+		if v, ok := portCfg["port"]; ok {
+			r.Port = int32(v.(int))
+		}
+		if v, ok := portCfg["protocol"]; ok {
+			r.Protocol = api.Protocol(v.(string))
+		}
+		ports[i] = r
+	}
+	return ports
+}
+
+func hashEndpointsSubset() schema.SchemaSetFunc {
+	return schema.HashResource(&schema.Resource{
+		Schema: endpointsSubsetFields(),
+	})
+}
+
+func hashEndpointsSubsetAddress() schema.SchemaSetFunc {
+	return schema.HashResource(&schema.Resource{
+		Schema: endpointsSubsetAddressFields(),
+	})
+}
+
+func hashEndpointsSubsetPort() schema.SchemaSetFunc {
+	return schema.HashResource(&schema.Resource{
+		Schema: endpointsSubsetPortFields(),
+	})
+}
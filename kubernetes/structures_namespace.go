@@ -0,0 +1,63 @@
+package kubernetes
+
+import (
+	"fmt"
+	"sort"
+
+	api "k8s.io/api/core/v1"
+)
+
+// The vendored Namespace predates status conditions, which tell why the
+// deletion of a namespace is stuck, so namespaces being deleted are read
+// through the mirror below, which embeds it.
+
+type namespace struct {
+	api.Namespace
+
+	Status namespaceStatus `json:"status,omitempty"`
+}
+
+type namespaceStatus struct {
+	Phase      api.NamespacePhase   `json:"phase,omitempty"`
+	Conditions []namespaceCondition `json:"conditions,omitempty"`
+}
+
+type namespaceCondition struct {
+	Type    string              `json:"type"`
+	Status  api.ConditionStatus `json:"status"`
+	Reason  string              `json:"reason,omitempty"`
+	Message string              `json:"message,omitempty"`
+}
+
+// stringifyNamespaceTermination describes what keeps a namespace from being
+// deleted: failing conditions, pending finalizers and the remaining objects,
+// counted per resource type.
+func stringifyNamespaceTermination(ns *namespace, remaining map[string]int) string {
+	var output string
+	for _, c := range ns.Status.Conditions {
+		if c.Status != api.ConditionTrue {
+			continue
+		}
+		output += fmt.Sprintf("\n   * %s: %s: %s", c.Type, c.Reason, c.Message)
+	}
+
+	var finalizers []string
+	for _, f := range ns.Spec.Finalizers {
+		finalizers = append(finalizers, string(f))
+	}
+	finalizers = append(finalizers, ns.Finalizers...)
+	for _, f := range finalizers {
+		output += fmt.Sprintf("\n   * Pending finalizer: %s", f)
+	}
+
+	resources := make([]string, 0, len(remaining))
+	for r := range remaining {
+		resources = append(resources, r)
+	}
+	sort.Strings(resources)
+	for _, r := range resources {
+		output += fmt.Sprintf("\n   * Remaining %s: %d", r, remaining[r])
+	}
+
+	return output
+}
@@ -0,0 +1,42 @@
+package kubernetes
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestStringifyNamespaceTermination(t *testing.T) {
+	cases := []struct {
+		Input          string
+		Remaining      map[string]int
+		ExpectedOutput string
+	}{
+		{
+			`{"metadata":{"name":"test"},"spec":{"finalizers":["kubernetes"]},"status":{"phase":"Terminating"}}`,
+			map[string]int{},
+			"\n   * Pending finalizer: kubernetes",
+		},
+		{
+			`{"metadata":{"name":"test","finalizers":["example.com/cleanup"]},"spec":{},"status":{"phase":"Terminating","conditions":[` +
+				`{"type":"NamespaceDeletionDiscoveryFailure","status":"True","reason":"DiscoveryFailed","message":"Discovery failed for some groups"},` +
+				`{"type":"NamespaceDeletionContentFailure","status":"False","reason":"ContentDeleted","message":"All content successfully deleted"}]}}`,
+			map[string]int{"widgets.example.com": 2, "pods": 1},
+			"\n   * NamespaceDeletionDiscoveryFailure: DiscoveryFailed: Discovery failed for some groups" +
+				"\n   * Pending finalizer: example.com/cleanup" +
+				"\n   * Remaining pods: 1" +
+				"\n   * Remaining widgets.example.com: 2",
+		},
+	}
+
+	for _, tc := range cases {
+		ns := &namespace{}
+		err := json.Unmarshal([]byte(tc.Input), ns)
+		if err != nil {
+			t.Fatal(err)
+		}
+		output := stringifyNamespaceTermination(ns, tc.Remaining)
+		if output != tc.ExpectedOutput {
+			t.Fatalf("Unexpected output.\nExpected: %q\nGiven:    %q", tc.ExpectedOutput, output)
+		}
+	}
+}
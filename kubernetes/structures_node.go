@@ -0,0 +1,56 @@
+package kubernetes
+
+import (
+	"k8s.io/api/core/v1"
+)
+
+func flattenNodes(in []v1.Node) []interface{} {
+	att := make([]interface{}, len(in), len(in))
+	for i, n := range in {
+		m := make(map[string]interface{})
+		m["name"] = n.Name
+		m["labels"] = n.Labels
+		m["annotations"] = n.Annotations
+		m["taints"] = flattenNodeTaints(n.Spec.Taints)
+		m["addresses"] = flattenNodeAddresses(n.Status.Addresses)
+		m["capacity"] = flattenResourceList(n.Status.Capacity)
+		m["allocatable"] = flattenResourceList(n.Status.Allocatable)
+		m["kubelet_version"] = n.Status.NodeInfo.KubeletVersion
+		m["os_image"] = n.Status.NodeInfo.OSImage
+		m["ready"] = isNodeReady(n.Status.Conditions)
+		att[i] = m
+	}
+	return att
+}
+
+func flattenNodeTaints(in []v1.Taint) []interface{} {
+	att := make([]interface{}, len(in), len(in))
+	for i, t := range in {
+		m := make(map[string]interface{})
+		m["key"] = t.Key
+		m["value"] = t.Value
+		m["effect"] = string(t.Effect)
+		att[i] = m
+	}
+	return att
+}
+
+func flattenNodeAddresses(in []v1.NodeAddress) []interface{} {
+	att := make([]interface{}, len(in), len(in))
+	for i, a := range in {
+		m := make(map[string]interface{})
+		m["type"] = string(a.Type)
+		m["address"] = a.Address
+		att[i] = m
+	}
+	return att
+}
+
+func isNodeReady(conditions []v1.NodeCondition) bool {
+	for _, c := range conditions {
+		if c.Type == v1.NodeReady {
+			return c.Status == v1.ConditionTrue
+		}
+	}
+	return false
+}
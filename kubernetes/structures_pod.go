@@ -76,10 +76,10 @@ func flattenPodSpec(in v1.PodSpec) ([]interface{}, error) {
 	return []interface{}{att}, nil
 }
 
-func flattenPodTemplateSpec(in v1.PodTemplateSpec, d *schema.ResourceData) ([]interface{}, error) {
+func flattenPodTemplateSpec(in v1.PodTemplateSpec, d *schema.ResourceData, settings metadataSettings) ([]interface{}, error) {
 	att := make(map[string]interface{})
 
-	meta := flattenMetadata(in.ObjectMeta, d)
+	meta := flattenMetadata(in.ObjectMeta, d, settings)
 	att["metadata"] = meta
 
 	podSpec, err := flattenPodSpec(in.Spec)
@@ -128,7 +128,7 @@ func flattenSeLinuxOptions(in *v1.SELinuxOptions) []interface{} {
 	if in.Role != "" {
 		att["role"] = in.Role
 	}
-	if in.User != "" {
+	if in.Type != "" {
 		att["type"] = in.Type
 	}
 	if in.Level != "" {
@@ -330,9 +330,40 @@ func flattenSecretVolumeSource(in *v1.SecretVolumeSource) []interface{} {
 	return []interface{}{att}
 }
 
+func flattenPods(in []v1.Pod) []interface{} {
+	att := make([]interface{}, len(in), len(in))
+	for i, p := range in {
+		m := make(map[string]interface{})
+		m["name"] = p.Name
+		m["namespace"] = p.Namespace
+		m["labels"] = p.Labels
+		m["phase"] = string(p.Status.Phase)
+		m["pod_ip"] = p.Status.PodIP
+		m["host_ip"] = p.Status.HostIP
+		m["node_name"] = p.Spec.NodeName
+		m["container_status"] = flattenContainerStatuses(p.Status.ContainerStatuses)
+		att[i] = m
+	}
+	return att
+}
+
+func flattenContainerStatuses(in []v1.ContainerStatus) []interface{} {
+	att := make([]interface{}, len(in), len(in))
+	for i, c := range in {
+		m := make(map[string]interface{})
+		m["name"] = c.Name
+		m["ready"] = c.Ready
+		m["restart_count"] = int(c.RestartCount)
+		m["image"] = c.Image
+		m["image_id"] = c.ImageID
+		att[i] = m
+	}
+	return att
+}
+
 // Expanders
 
-func expandPodTemplateSpec(template map[string]interface{}) (v1.PodTemplateSpec, error) {
+func expandPodTemplateSpec(template map[string]interface{}, settings metadataSettings) (v1.PodTemplateSpec, error) {
 	obj := v1.PodTemplateSpec{}
 
 	podSpec, err := expandPodSpec(template["spec"].([]interface{}))
@@ -342,7 +373,7 @@ func expandPodTemplateSpec(template map[string]interface{}) (v1.PodTemplateSpec,
 	obj.Spec = podSpec
 
 	if metaCfg, ok := template["metadata"]; ok {
-		metadata := expandMetadata(metaCfg.([]interface{}))
+		metadata := expandMetadata(metaCfg.([]interface{}), settings)
 		obj.ObjectMeta = metadata
 	}
 
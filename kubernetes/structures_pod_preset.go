@@ -0,0 +1,59 @@
+package kubernetes
+
+import (
+	"k8s.io/api/settings/v1alpha1"
+)
+
+// Flatteners
+
+func flattenPodPresetSpec(in v1alpha1.PodPresetSpec) ([]interface{}, error) {
+	att := make(map[string]interface{})
+	att["selector"] = flattenLabelSelector(&in.Selector)
+	att["env"] = flattenContainerEnvs(in.Env)
+	att["env_from"] = flattenContainerEnvFroms(in.EnvFrom)
+
+	volumes, err := flattenVolumes(in.Volumes)
+	if err != nil {
+		return nil, err
+	}
+	att["volume"] = volumes
+
+	mounts, err := flattenContainerVolumeMounts(in.VolumeMounts)
+	if err != nil {
+		return nil, err
+	}
+	att["volume_mount"] = mounts
+
+	return []interface{}{att}, nil
+}
+
+// Expanders
+
+func expandPodPresetSpec(l []interface{}) (v1alpha1.PodPresetSpec, error) {
+	obj := v1alpha1.PodPresetSpec{}
+	if len(l) == 0 || l[0] == nil {
+		return obj, nil
+	}
+	in := l[0].(map[string]interface{})
+	obj.Selector = *expandLabelSelector(in["selector"].([]interface{}))
+
+	var err error
+	obj.Env, err = expandContainerEnv(in["env"].([]interface{}))
+	if err != nil {
+		return obj, err
+	}
+	obj.EnvFrom, err = expandContainerEnvFrom(in["env_from"].([]interface{}))
+	if err != nil {
+		return obj, err
+	}
+	obj.Volumes, err = expandVolumes(in["volume"].([]interface{}))
+	if err != nil {
+		return obj, err
+	}
+	obj.VolumeMounts, err = expandContainerVolumeMounts(in["volume_mount"].([]interface{}))
+	if err != nil {
+		return obj, err
+	}
+
+	return obj, nil
+}
@@ -0,0 +1,176 @@
+package kubernetes
+
+import (
+	"k8s.io/api/core/v1"
+	api "k8s.io/api/extensions/v1beta1"
+)
+
+// Flatteners
+
+func flattenPodSecurityPolicySpec(in api.PodSecurityPolicySpec) []interface{} {
+	att := make(map[string]interface{})
+	att["privileged"] = in.Privileged
+	att["default_add_capabilities"] = flattenCapabilities(in.DefaultAddCapabilities)
+	att["required_drop_capabilities"] = flattenCapabilities(in.RequiredDropCapabilities)
+	att["allowed_capabilities"] = flattenCapabilities(in.AllowedCapabilities)
+	volumes := make([]string, len(in.Volumes), len(in.Volumes))
+	for i, v := range in.Volumes {
+		volumes[i] = string(v)
+	}
+	att["volumes"] = volumes
+	att["host_network"] = in.HostNetwork
+	att["host_pid"] = in.HostPID
+	att["host_ipc"] = in.HostIPC
+	att["host_ports"] = flattenHostPortRanges(in.HostPorts)
+	att["se_linux"] = flattenSELinuxStrategyOptions(in.SELinux)
+	att["run_as_user"] = flattenIDRangeStrategyOptions(string(in.RunAsUser.Rule), in.RunAsUser.Ranges)
+	att["supplemental_groups"] = flattenIDRangeStrategyOptions(string(in.SupplementalGroups.Rule), in.SupplementalGroups.Ranges)
+	att["fs_group"] = flattenIDRangeStrategyOptions(string(in.FSGroup.Rule), in.FSGroup.Ranges)
+	att["read_only_root_filesystem"] = in.ReadOnlyRootFilesystem
+	if in.AllowPrivilegeEscalation != nil {
+		att["allow_privilege_escalation"] = *in.AllowPrivilegeEscalation
+	}
+	hostPaths := make([]interface{}, len(in.AllowedHostPaths), len(in.AllowedHostPaths))
+	for i, p := range in.AllowedHostPaths {
+		hostPaths[i] = map[string]interface{}{
+			"path_prefix": p.PathPrefix,
+		}
+	}
+	att["allowed_host_paths"] = hostPaths
+	return []interface{}{att}
+}
+
+func flattenCapabilities(in []v1.Capability) []string {
+	att := make([]string, len(in), len(in))
+	for i, c := range in {
+		att[i] = string(c)
+	}
+	return att
+}
+
+func flattenHostPortRanges(in []api.HostPortRange) []interface{} {
+	att := make([]interface{}, len(in), len(in))
+	for i, r := range in {
+		att[i] = map[string]interface{}{
+			"min": int(r.Min),
+			"max": int(r.Max),
+		}
+	}
+	return att
+}
+
+func flattenSELinuxStrategyOptions(in api.SELinuxStrategyOptions) []interface{} {
+	att := make(map[string]interface{})
+	att["rule"] = string(in.Rule)
+	if in.SELinuxOptions != nil {
+		att["se_linux_options"] = flattenSeLinuxOptions(in.SELinuxOptions)
+	}
+	return []interface{}{att}
+}
+
+func flattenIDRangeStrategyOptions(rule string, ranges []api.IDRange) []interface{} {
+	att := make(map[string]interface{})
+	att["rule"] = rule
+	r := make([]interface{}, len(ranges), len(ranges))
+	for i, v := range ranges {
+		r[i] = map[string]interface{}{
+			"min": int(v.Min),
+			"max": int(v.Max),
+		}
+	}
+	att["range"] = r
+	return []interface{}{att}
+}
+
+// Expanders
+
+func expandPodSecurityPolicySpec(l []interface{}) api.PodSecurityPolicySpec {
+	obj := api.PodSecurityPolicySpec{}
+	if len(l) == 0 || l[0] == nil {
+		return obj
+	}
+	in := l[0].(map[string]interface{})
+
+	obj.Privileged = in["privileged"].(bool)
+	obj.DefaultAddCapabilities = expandCapabilities(in["default_add_capabilities"].([]interface{}))
+	obj.RequiredDropCapabilities = expandCapabilities(in["required_drop_capabilities"].([]interface{}))
+	obj.AllowedCapabilities = expandCapabilities(in["allowed_capabilities"].([]interface{}))
+	for _, v := range in["volumes"].([]interface{}) {
+		obj.Volumes = append(obj.Volumes, api.FSType(v.(string)))
+	}
+	obj.HostNetwork = in["host_network"].(bool)
+	obj.HostPID = in["host_pid"].(bool)
+	obj.HostIPC = in["host_ipc"].(bool)
+	for _, v := range in["host_ports"].([]interface{}) {
+		r := v.(map[string]interface{})
+		obj.HostPorts = append(obj.HostPorts, api.HostPortRange{
+			Min: int32(r["min"].(int)),
+			Max: int32(r["max"].(int)),
+		})
+	}
+	obj.SELinux = expandSELinuxStrategyOptions(in["se_linux"].([]interface{}))
+	rule, ranges := expandIDRangeStrategyOptions(in["run_as_user"].([]interface{}))
+	obj.RunAsUser = api.RunAsUserStrategyOptions{
+		Rule:   api.RunAsUserStrategy(rule),
+		Ranges: ranges,
+	}
+	rule, ranges = expandIDRangeStrategyOptions(in["supplemental_groups"].([]interface{}))
+	obj.SupplementalGroups = api.SupplementalGroupsStrategyOptions{
+		Rule:   api.SupplementalGroupsStrategyType(rule),
+		Ranges: ranges,
+	}
+	rule, ranges = expandIDRangeStrategyOptions(in["fs_group"].([]interface{}))
+	obj.FSGroup = api.FSGroupStrategyOptions{
+		Rule:   api.FSGroupStrategyType(rule),
+		Ranges: ranges,
+	}
+	obj.ReadOnlyRootFilesystem = in["read_only_root_filesystem"].(bool)
+	obj.AllowPrivilegeEscalation = ptrToBool(in["allow_privilege_escalation"].(bool))
+	for _, v := range in["allowed_host_paths"].([]interface{}) {
+		p := v.(map[string]interface{})
+		obj.AllowedHostPaths = append(obj.AllowedHostPaths, api.AllowedHostPath{
+			PathPrefix: p["path_prefix"].(string),
+		})
+	}
+	return obj
+}
+
+func expandCapabilities(l []interface{}) []v1.Capability {
+	if len(l) == 0 {
+		return nil
+	}
+	obj := make([]v1.Capability, len(l), len(l))
+	for i, c := range l {
+		obj[i] = v1.Capability(c.(string))
+	}
+	return obj
+}
+
+func expandSELinuxStrategyOptions(l []interface{}) api.SELinuxStrategyOptions {
+	obj := api.SELinuxStrategyOptions{}
+	if len(l) == 0 || l[0] == nil {
+		return obj
+	}
+	in := l[0].(map[string]interface{})
+	obj.Rule = api.SELinuxStrategy(in["rule"].(string))
+	if v, ok := in["se_linux_options"].([]interface{}); ok && len(v) > 0 {
+		obj.SELinuxOptions = expandSeLinuxOptions(v)
+	}
+	return obj
+}
+
+func expandIDRangeStrategyOptions(l []interface{}) (string, []api.IDRange) {
+	if len(l) == 0 || l[0] == nil {
+		return "", nil
+	}
+	in := l[0].(map[string]interface{})
+	var ranges []api.IDRange
+	for _, v := range in["range"].([]interface{}) {
+		r := v.(map[string]interface{})
+		ranges = append(ranges, api.IDRange{
+			Min: int64(r["min"].(int)),
+			Max: int64(r["max"].(int)),
+		})
+	}
+	return in["rule"].(string), ranges
+}
@@ -0,0 +1,41 @@
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/api/core/v1"
+)
+
+func TestFlattenSeLinuxOptions(t *testing.T) {
+	cases := []struct {
+		Input          *v1.SELinuxOptions
+		ExpectedOutput []interface{}
+	}{
+		{
+			&v1.SELinuxOptions{Type: "spc_t"},
+			[]interface{}{
+				map[string]interface{}{
+					"type": "spc_t",
+				},
+			},
+		},
+		{
+			&v1.SELinuxOptions{User: "system_u", Level: "s0:c123,c456"},
+			[]interface{}{
+				map[string]interface{}{
+					"user":  "system_u",
+					"level": "s0:c123,c456",
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenSeLinuxOptions(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// The vendored ResourceQuotaSpec predates scope selectors, so resource
+// quotas are sent and received through the mirror below, which embeds it.
+
+type resourceQuota struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   resourceQuotaSpec       `json:"spec,omitempty"`
+	Status api.ResourceQuotaStatus `json:"status,omitempty"`
+}
+
+type resourceQuotaSpec struct {
+	api.ResourceQuotaSpec
+
+	ScopeSelector *resourceQuotaScopeSelector `json:"scopeSelector,omitempty"`
+}
+
+type resourceQuotaScopeSelector struct {
+	MatchExpressions []resourceQuotaScopedSelectorRequirement `json:"matchExpressions,omitempty"`
+}
+
+type resourceQuotaScopedSelectorRequirement struct {
+	ScopeName string   `json:"scopeName"`
+	Operator  string   `json:"operator"`
+	Values    []string `json:"values,omitempty"`
+}
+
+// Flatteners
+
+func flattenResourceQuotaScopeSelector(in *resourceQuotaScopeSelector) []interface{} {
+	if in == nil || len(in.MatchExpressions) == 0 {
+		return []interface{}{}
+	}
+	exprs := make([]interface{}, len(in.MatchExpressions), len(in.MatchExpressions))
+	for i, e := range in.MatchExpressions {
+		exprs[i] = map[string]interface{}{
+			"scope_name": e.ScopeName,
+			"operator":   e.Operator,
+			"values":     newStringSet(schema.HashString, e.Values),
+		}
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"match_expressions": exprs,
+		},
+	}
+}
+
+func flattenResourceQuotaStatus(in api.ResourceQuotaStatus) []interface{} {
+	att := make(map[string]interface{})
+	att["hard"] = flattenResourceList(in.Hard)
+	att["used"] = flattenResourceList(in.Used)
+	return []interface{}{att}
+}
+
+// Expanders
+
+func expandResourceQuotaScopeSelector(l []interface{}) *resourceQuotaScopeSelector {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	in := l[0].(map[string]interface{})
+	exprs := in["match_expressions"].([]interface{})
+	if len(exprs) == 0 {
+		return nil
+	}
+	obj := &resourceQuotaScopeSelector{}
+	for _, e := range exprs {
+		m := e.(map[string]interface{})
+		obj.MatchExpressions = append(obj.MatchExpressions, resourceQuotaScopedSelectorRequirement{
+			ScopeName: m["scope_name"].(string),
+			Operator:  m["operator"].(string),
+			Values:    sliceOfString(m["values"].(*schema.Set).List()),
+		})
+	}
+	return obj
+}
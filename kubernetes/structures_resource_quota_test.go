@@ -0,0 +1,59 @@
+package kubernetes
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestResourceQuotaSpecJSON(t *testing.T) {
+	cases := []struct {
+		Input          resourceQuotaSpec
+		ExpectedOutput string
+	}{
+		{
+			resourceQuotaSpec{
+				ResourceQuotaSpec: api.ResourceQuotaSpec{
+					Hard: api.ResourceList{api.ResourcePods: resource.MustParse("10")},
+				},
+			},
+			`{"hard":{"pods":"10"}}`,
+		},
+		{
+			resourceQuotaSpec{
+				ResourceQuotaSpec: api.ResourceQuotaSpec{
+					Hard: api.ResourceList{api.ResourcePods: resource.MustParse("10")},
+				},
+				ScopeSelector: &resourceQuotaScopeSelector{
+					MatchExpressions: []resourceQuotaScopedSelectorRequirement{
+						{ScopeName: "PriorityClass", Operator: "In", Values: []string{"high"}},
+					},
+				},
+			},
+			`{"hard":{"pods":"10"},"scopeSelector":{"matchExpressions":[{"scopeName":"PriorityClass","operator":"In","values":["high"]}]}}`,
+		},
+	}
+
+	for _, tc := range cases {
+		output, err := json.Marshal(tc.Input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(output) != tc.ExpectedOutput {
+			t.Fatalf("Unexpected JSON.\nExpected: %s\nGiven:    %s", tc.ExpectedOutput, output)
+		}
+
+		var decoded resourceQuotaSpec
+		err = json.Unmarshal(output, &decoded)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(decoded.ScopeSelector, tc.Input.ScopeSelector) {
+			t.Fatalf("Unexpected scope selector after decoding.\nExpected: %#v\nGiven:    %#v",
+				tc.Input.ScopeSelector, decoded.ScopeSelector)
+		}
+	}
+}
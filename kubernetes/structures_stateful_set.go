@@ -9,7 +9,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-func flattenStatefulSetSpec(in appsv1.StatefulSetSpec, d *schema.ResourceData) ([]interface{}, error) {
+func flattenStatefulSetSpec(in appsv1.StatefulSetSpec, d *schema.ResourceData, settings metadataSettings) ([]interface{}, error) {
 	att := make(map[string]interface{})
 
 	if in.Replicas != nil {
@@ -23,7 +23,7 @@ func flattenStatefulSetSpec(in appsv1.StatefulSetSpec, d *schema.ResourceData) (
 	att["selector"] = in.Selector.MatchLabels
 	att["update_strategy"] = flattenStatefulSetUpdateStrategy(in.UpdateStrategy, d)
 
-	templateMetadata := flattenMetadata(in.Template.ObjectMeta, d)
+	templateMetadata := flattenMetadata(in.Template.ObjectMeta, d, settings)
 	podSpec, err := flattenPodSpec(in.Template.Spec)
 	if err != nil {
 		return nil, err
@@ -36,7 +36,7 @@ func flattenStatefulSetSpec(in appsv1.StatefulSetSpec, d *schema.ResourceData) (
 	volClaimTemplates := make([]map[string]interface{}, len(in.VolumeClaimTemplates), len(in.VolumeClaimTemplates))
 	for i, claim := range in.VolumeClaimTemplates {
 		claimState := make(map[string]interface{})
-		claimState["metadata"] = flattenSubMetadata(claim.ObjectMeta, d, fmt.Sprintf("spec.0.volume_claim_templates.%d", i))
+		claimState["metadata"] = flattenSubMetadata(claim.ObjectMeta, d, fmt.Sprintf("spec.0.volume_claim_templates.%d", i), settings)
 		claimState["spec"] = flattenPersistentVolumeClaimSpec(claim.Spec)
 		volClaimTemplates[i] = claimState
 	}
@@ -69,7 +69,7 @@ func flattenStatefulSetStrategyRollingUpdate(in *appsv1.RollingUpdateStatefulSet
 // EXPANDERS
 //
 
-func expandStatefulSetSpec(statefulSet []interface{}) (appsv1.StatefulSetSpec, error) {
+func expandStatefulSetSpec(statefulSet []interface{}, settings metadataSettings) (appsv1.StatefulSetSpec, error) {
 	obj := appsv1.StatefulSetSpec{}
 	if len(statefulSet) == 0 || statefulSet[0] == nil {
 		return obj, nil
@@ -97,7 +97,7 @@ func expandStatefulSetSpec(statefulSet []interface{}) (appsv1.StatefulSetSpec, e
 		}
 
 		if metaCfg, ok := template["metadata"]; ok {
-			metadata := expandMetadata(metaCfg.([]interface{}))
+			metadata := expandMetadata(metaCfg.([]interface{}), settings)
 			obj.Template.ObjectMeta = metadata
 		}
 	}
@@ -106,7 +106,9 @@ func expandStatefulSetSpec(statefulSet []interface{}) (appsv1.StatefulSetSpec, e
 	pvcTemplates := make([]v1.PersistentVolumeClaim, len(volClaimTemplates), len(volClaimTemplates))
 	for i, claimTemplateRaw := range volClaimTemplates {
 		claimTemplateConfig := claimTemplateRaw.(map[string]interface{})
-		metadata := expandMetadata(claimTemplateConfig["metadata"].([]interface{}))
+		// Claim templates can't be updated, so provider defaults changing
+		// later on would break every update of the stateful set
+		metadata := expandConfiguredMetadata(claimTemplateConfig["metadata"].([]interface{}))
 		pvcSpec, _ := expandPersistentVolumeClaimSpec(claimTemplateConfig["spec"].([]interface{}))
 		claim := v1.PersistentVolumeClaim{
 			ObjectMeta: metadata,
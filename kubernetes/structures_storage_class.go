@@ -0,0 +1,79 @@
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	api "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// The vendored StorageClass predates allowed topologies, so storage classes
+// are sent and received through the mirror below, which embeds it.
+
+type storageClass struct {
+	api.StorageClass
+
+	AllowedTopologies []topologySelectorTerm `json:"allowedTopologies,omitempty"`
+}
+
+type topologySelectorTerm struct {
+	MatchLabelExpressions []topologySelectorLabelRequirement `json:"matchLabelExpressions,omitempty"`
+}
+
+type topologySelectorLabelRequirement struct {
+	Key    string   `json:"key"`
+	Values []string `json:"values"`
+}
+
+const (
+	storageClassIsDefaultAnnotation     = "storageclass.kubernetes.io/is-default-class"
+	storageClassBetaIsDefaultAnnotation = "storageclass.beta.kubernetes.io/is-default-class"
+)
+
+// isDefaultStorageClass tells whether the annotations of a storage class
+// make it the default of the cluster.
+func isDefaultStorageClass(meta metav1.ObjectMeta) bool {
+	return meta.Annotations[storageClassIsDefaultAnnotation] == "true" ||
+		meta.Annotations[storageClassBetaIsDefaultAnnotation] == "true"
+}
+
+// Flatteners
+
+func flattenTopologySelectorTerms(in []topologySelectorTerm) []interface{} {
+	att := make([]interface{}, len(in), len(in))
+	for i, term := range in {
+		exprs := make([]interface{}, len(term.MatchLabelExpressions), len(term.MatchLabelExpressions))
+		for j, e := range term.MatchLabelExpressions {
+			exprs[j] = map[string]interface{}{
+				"key":    e.Key,
+				"values": newStringSet(schema.HashString, e.Values),
+			}
+		}
+		att[i] = map[string]interface{}{
+			"match_label_expressions": exprs,
+		}
+	}
+	return att
+}
+
+// Expanders
+
+func expandTopologySelectorTerms(l []interface{}) []topologySelectorTerm {
+	if len(l) == 0 {
+		return nil
+	}
+	obj := make([]topologySelectorTerm, len(l), len(l))
+	for i, t := range l {
+		term, ok := t.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, e := range term["match_label_expressions"].([]interface{}) {
+			expr := e.(map[string]interface{})
+			obj[i].MatchLabelExpressions = append(obj[i].MatchLabelExpressions, topologySelectorLabelRequirement{
+				Key:    expr["key"].(string),
+				Values: sliceOfString(expr["values"].(*schema.Set).List()),
+			})
+		}
+	}
+	return obj
+}
@@ -2,7 +2,14 @@ package kubernetes
 
 import (
 	"fmt"
+	"reflect"
+	"regexp"
 	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestIsInternalKey(t *testing.T) {
@@ -30,3 +37,324 @@ func TestIsInternalKey(t *testing.T) {
 		})
 	}
 }
+
+func TestRemoveInternalFinalizers(t *testing.T) {
+	testCases := []struct {
+		Finalizers []string
+		Config     []interface{}
+		Expected   []string
+	}{
+		{[]string{}, []interface{}{}, []string{}},
+		{[]string{"example.com/cleanup"}, []interface{}{}, []string{"example.com/cleanup"}},
+		{[]string{"kubernetes.io/pvc-protection", "example.com/cleanup"}, []interface{}{"example.com/cleanup"}, []string{"example.com/cleanup"}},
+		{[]string{"kubernetes.io/pvc-protection"}, []interface{}{"kubernetes.io/pvc-protection"}, []string{"kubernetes.io/pvc-protection"}},
+		{[]string{"foregroundDeletion", "orphan"}, []interface{}{}, []string{}},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			out := removeInternalFinalizers(tc.Finalizers, tc.Config)
+			if !reflect.DeepEqual(out, tc.Expected) {
+				t.Fatalf("Expected %q, given %q", tc.Expected, out)
+			}
+		})
+	}
+}
+
+func TestOwnerReferences(t *testing.T) {
+	refs := []metav1.OwnerReference{
+		{
+			APIVersion: "example.com/v1",
+			Kind:       "Widget",
+			Name:       "parent",
+			UID:        "6e2a3d3c-8f1a-11e8-9eb6-529269fb1459",
+			Controller: ptrToBool(true),
+		},
+		{
+			APIVersion:         "v1",
+			Kind:               "ConfigMap",
+			Name:               "other",
+			UID:                "7b1c8a0e-8f1a-11e8-9eb6-529269fb1459",
+			BlockOwnerDeletion: ptrToBool(true),
+		},
+	}
+
+	out := expandOwnerReferences(flattenOwnerReferences(refs))
+	if !reflect.DeepEqual(out, refs) {
+		t.Fatalf("Expected %#v, given %#v", refs, out)
+	}
+}
+
+func TestPatchList(t *testing.T) {
+	testCases := []struct {
+		OldLen   int
+		New      []string
+		Expected PatchOperation
+	}{
+		{0, []string{"a"}, &AddOperation{Path: "/metadata/finalizers", Value: []string{"a"}}},
+		{1, []string{}, &RemoveOperation{Path: "/metadata/finalizers"}},
+		{1, []string{"a", "b"}, &ReplaceOperation{Path: "/metadata/finalizers", Value: []string{"a", "b"}}},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			op := patchList("/metadata/finalizers", tc.OldLen, tc.New)
+			if !reflect.DeepEqual(op, tc.Expected) {
+				t.Fatalf("Expected %#v, given %#v", tc.Expected, op)
+			}
+		})
+	}
+}
+
+func TestExpandMetadataWithDefaults(t *testing.T) {
+	settings := metadataSettings{
+		DefaultLabels:      map[string]string{"team": "infra", "managed-by": "terraform"},
+		DefaultAnnotations: map[string]string{"example.com/owner": "infra"},
+	}
+
+	meta := expandMetadata([]interface{}{map[string]interface{}{
+		"annotations": map[string]interface{}{},
+		"labels":      map[string]interface{}{"team": "storage", "app": "db"},
+		"name":        "test",
+	}}, settings)
+	expectedLabels := map[string]string{"team": "storage", "managed-by": "terraform", "app": "db"}
+	if !reflect.DeepEqual(meta.Labels, expectedLabels) {
+		t.Fatalf("Expected labels %q, given %q", expectedLabels, meta.Labels)
+	}
+	if !reflect.DeepEqual(meta.Annotations, settings.DefaultAnnotations) {
+		t.Fatalf("Expected annotations %q, given %q", settings.DefaultAnnotations, meta.Annotations)
+	}
+}
+
+func TestRemoveDefaultKeys(t *testing.T) {
+	defaults := map[string]string{"team": "infra", "managed-by": "terraform"}
+	testCases := []struct {
+		Labels   map[string]string
+		Config   map[string]interface{}
+		Expected map[string]string
+	}{
+		{
+			map[string]string{"team": "infra", "managed-by": "terraform", "app": "db"},
+			map[string]interface{}{"app": "db"},
+			map[string]string{"app": "db"},
+		},
+		{
+			map[string]string{"team": "storage", "managed-by": "terraform"},
+			map[string]interface{}{"team": "storage"},
+			map[string]string{"team": "storage"},
+		},
+		{
+			map[string]string{"team": "infra", "managed-by": "terraform"},
+			map[string]interface{}{"team": "infra"},
+			map[string]string{"team": "infra"},
+		},
+		{
+			// Drift from the default shows up
+			map[string]string{"team": "changed", "managed-by": "terraform"},
+			map[string]interface{}{},
+			map[string]string{"team": "changed"},
+		},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			out := removeDefaultKeys(tc.Labels, defaults, tc.Config)
+			if !reflect.DeepEqual(out, tc.Expected) {
+				t.Fatalf("Expected %q, given %q", tc.Expected, out)
+			}
+		})
+	}
+}
+
+func TestRemoveInternalKeys(t *testing.T) {
+	ignored := []*regexp.Regexp{
+		regexp.MustCompile(`^sidecar\.istio\.io/`),
+		regexp.MustCompile(`^fluxcd\.io/sync-checksum$`),
+	}
+	testCases := []struct {
+		Keys     map[string]string
+		Config   map[string]interface{}
+		Ignored  []*regexp.Regexp
+		Expected map[string]string
+	}{
+		{
+			map[string]string{"app": "db", "sidecar.istio.io/status": "injected"},
+			map[string]interface{}{"app": "db"},
+			nil,
+			map[string]string{"app": "db", "sidecar.istio.io/status": "injected"},
+		},
+		{
+			map[string]string{"app": "db", "sidecar.istio.io/status": "injected", "fluxcd.io/sync-checksum": "abc", "pv.kubernetes.io/bound-by-controller": "yes"},
+			map[string]interface{}{"app": "db"},
+			ignored,
+			map[string]string{"app": "db"},
+		},
+		{
+			map[string]string{"app": "db", "sidecar.istio.io/inject": "false"},
+			map[string]interface{}{"app": "db", "sidecar.istio.io/inject": "false"},
+			ignored,
+			map[string]string{"app": "db", "sidecar.istio.io/inject": "false"},
+		},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			out := removeInternalKeys(tc.Keys, tc.Config, tc.Ignored)
+			if !reflect.DeepEqual(out, tc.Expected) {
+				t.Fatalf("Expected %q, given %q", tc.Expected, out)
+			}
+		})
+	}
+}
+
+func TestDiffStringMapKeepsIgnoredKeys(t *testing.T) {
+	ignored := []*regexp.Regexp{regexp.MustCompile(`^sidecar\.istio\.io/`)}
+	oldV := map[string]interface{}{"app": "db", "tier": "backend", "sidecar.istio.io/inject": "false"}
+	newV := map[string]interface{}{"app": "web"}
+
+	ops := diffStringMap("/metadata/annotations", oldV, keepIgnoredKeys(oldV, newV, ignored))
+	expected := PatchOperations{
+		&RemoveOperation{Path: "/metadata/annotations/tier"},
+		&ReplaceOperation{Path: "/metadata/annotations/app", Value: "web"},
+	}
+	if !ops.Equal(expected) {
+		t.Fatalf("Expected %s, given %s", expected, ops)
+	}
+}
+
+func TestDiffIgnoredStringMapFromEmptyState(t *testing.T) {
+	ignored := []*regexp.Regexp{regexp.MustCompile(`^sidecar\.istio\.io/`)}
+
+	testCases := []struct {
+		LiveMapSet bool
+		Expected   PatchOperations
+	}{
+		// The live map may only hold ignored keys, which Read left out
+		{true, PatchOperations{
+			&AddOperation{Path: "/metadata/annotations/app", Value: "web"},
+		}},
+		{false, PatchOperations{
+			&AddOperation{Path: "/metadata/annotations", Value: map[string]interface{}{"app": "web"}},
+		}},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			ops := diffIgnoredStringMap("/metadata/annotations", map[string]interface{}{}, map[string]interface{}{"app": "web"},
+				ignored, func() bool { return tc.LiveMapSet })
+			if !ops.Equal(tc.Expected) {
+				t.Fatalf("Expected %s, given %s", tc.Expected, ops)
+			}
+		})
+	}
+}
+
+func TestKeepIgnoredStringMap(t *testing.T) {
+	ignored := []*regexp.Regexp{regexp.MustCompile(`^sidecar\.istio\.io/`)}
+	live := map[string]string{"app": "db", "sidecar.istio.io/inject": "false"}
+	m := map[string]string{"app": "web"}
+
+	result := keepIgnoredStringMap(live, m, ignored)
+	expected := map[string]string{"app": "web", "sidecar.istio.io/inject": "false"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Fatalf("Expected %v, given %v", expected, result)
+	}
+	if len(m) != 1 {
+		t.Fatalf("Expected the given map to be left as is, given %v", m)
+	}
+}
+
+func TestExpandDeleteOptions(t *testing.T) {
+	foreground := metav1.DeletePropagationForeground
+	orphan := metav1.DeletePropagationOrphan
+
+	testCases := []struct {
+		Input         []interface{}
+		DefaultPolicy metav1.DeletionPropagation
+		Expected      *metav1.DeleteOptions
+	}{
+		{[]interface{}{}, "", &metav1.DeleteOptions{}},
+		{[]interface{}{}, foreground, &metav1.DeleteOptions{PropagationPolicy: &foreground}},
+		{
+			[]interface{}{map[string]interface{}{"propagation_policy": "", "grace_period_seconds": -1}},
+			foreground,
+			&metav1.DeleteOptions{PropagationPolicy: &foreground},
+		},
+		{
+			[]interface{}{map[string]interface{}{"propagation_policy": "", "grace_period_seconds": 0}},
+			"",
+			&metav1.DeleteOptions{GracePeriodSeconds: ptrToInt64(0)},
+		},
+		{
+			[]interface{}{map[string]interface{}{"propagation_policy": "Orphan", "grace_period_seconds": 30}},
+			foreground,
+			&metav1.DeleteOptions{PropagationPolicy: &orphan, GracePeriodSeconds: ptrToInt64(30)},
+		},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			output := expandDeleteOptions(tc.Input, tc.DefaultPolicy)
+			if !reflect.DeepEqual(output, tc.Expected) {
+				t.Fatalf("Expected %#v, given %#v", tc.Expected, output)
+			}
+		})
+	}
+}
+
+func TestMetadataNamespace(t *testing.T) {
+	settings := metadataSettings{DefaultNamespace: "staging"}
+	dataSources := map[string]*schema.Resource{
+		"kubernetes_config_map":                 dataSourceKubernetesConfigMap(),
+		"kubernetes_secret":                     dataSourceKubernetesSecret(),
+		"kubernetes_service":                    dataSourceKubernetesService(),
+		"kubernetes_service_account_kubeconfig": dataSourceKubernetesServiceAccountKubeconfig(),
+	}
+
+	testCases := []struct {
+		Metadata map[string]interface{}
+		Expected string
+	}{
+		{map[string]interface{}{"name": "example"}, "staging"},
+		{map[string]interface{}{"name": "example", "namespace": "kube-system"}, "kube-system"},
+	}
+	for name, r := range dataSources {
+		for _, tc := range testCases {
+			d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+				"metadata": []interface{}{tc.Metadata},
+			})
+			namespace := metadataNamespace(d, settings)
+			if namespace != tc.Expected {
+				t.Fatalf("%s: expected namespace %q for %v, given %q", name, tc.Expected, tc.Metadata, namespace)
+			}
+		}
+	}
+}
+
+func TestCustomizeDiffBinaryDataOverlap(t *testing.T) {
+	testCases := []struct {
+		Data          map[string]interface{}
+		BinaryData    map[string]interface{}
+		ExpectedError string
+	}{
+		{map[string]interface{}{"one": "plain"}, map[string]interface{}{"two": "YmluYXJ5"}, ""},
+		{map[string]interface{}{"one": "plain"}, map[string]interface{}{"one": "YmluYXJ5", "two": "YmluYXJ5"}, "binary_data.one: key is also set in data"},
+	}
+	resources := map[string]*schema.Resource{
+		"kubernetes_config_map": resourceKubernetesConfigMap(),
+		"kubernetes_secret":     resourceKubernetesSecret(),
+	}
+	for rtype, r := range resources {
+		for _, tc := range testCases {
+			raw, err := config.NewRawConfig(map[string]interface{}{
+				"metadata":    []interface{}{map[string]interface{}{"name": "example"}},
+				"data":        tc.Data,
+				"binary_data": tc.BinaryData,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = r.Diff(nil, terraform.NewResourceConfig(raw), nil)
+			if tc.ExpectedError == "" && err != nil {
+				t.Fatalf("Expected no error for %s with binary data %v, given %s", rtype, tc.BinaryData, err)
+			}
+			if tc.ExpectedError != "" && (err == nil || err.Error() != tc.ExpectedError) {
+				t.Fatalf("Expected error %q for %s with binary data %v, given %v", tc.ExpectedError, rtype, tc.BinaryData, err)
+			}
+		}
+	}
+}
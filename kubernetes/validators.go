@@ -1,6 +1,8 @@
 package kubernetes
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -10,6 +12,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	apiValidation "k8s.io/apimachinery/pkg/api/validation"
 	"k8s.io/apimachinery/pkg/api/validation/path"
+	"k8s.io/apimachinery/pkg/fields"
 	utilValidation "k8s.io/apimachinery/pkg/util/validation"
 )
 
@@ -26,6 +29,28 @@ func validateAnnotations(value interface{}, key string) (ws []string, es []error
 	return
 }
 
+func validateBase64EncodedMap(value interface{}, key string) (ws []string, es []error) {
+	m := value.(map[string]interface{})
+	for k, v := range m {
+		if _, err := base64.StdEncoding.DecodeString(v.(string)); err != nil {
+			es = append(es, fmt.Errorf("%s (%q) must be base64 encoded: %s", key, k, err))
+		}
+	}
+	return
+}
+
+func validateJSON(value interface{}, key string) (ws []string, es []error) {
+	v := value.(string)
+	if v == "" {
+		return
+	}
+	var j interface{}
+	if err := json.Unmarshal([]byte(v), &j); err != nil {
+		es = append(es, fmt.Errorf("%s contains invalid JSON: %s", key, err))
+	}
+	return
+}
+
 func validateName(value interface{}, key string) (ws []string, es []error) {
 	v := value.(string)
 
@@ -147,6 +172,14 @@ func validateResourceQuantity(value interface{}, key string) (ws []string, es []
 	return
 }
 
+func validateFieldSelector(value interface{}, key string) (ws []string, es []error) {
+	v := value.(string)
+	if _, err := fields.ParseSelector(v); err != nil {
+		es = append(es, fmt.Errorf("%s.%s : %s", key, v, err))
+	}
+	return
+}
+
 func validatePositiveInteger(value interface{}, key string) (ws []string, es []error) {
 	v := value.(int)
 	if v <= 0 {
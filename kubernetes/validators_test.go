@@ -25,3 +25,78 @@ func TestValidateModeBits(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateBase64EncodedMap(t *testing.T) {
+	validCases := []map[string]interface{}{
+		{},
+		{"one": "dGZwbGFu", "two": ""},
+		{"padded": "AAE="},
+	}
+	for _, m := range validCases {
+		_, es := validateBase64EncodedMap(m, "binary_data")
+		if len(es) > 0 {
+			t.Fatalf("Expected %#v to be valid: %#v", m, es)
+		}
+	}
+
+	invalidCases := []map[string]interface{}{
+		{"plain": "not base64!"},
+		{"truncated": "AAE"},
+	}
+	for _, m := range invalidCases {
+		_, es := validateBase64EncodedMap(m, "binary_data")
+		if len(es) == 0 {
+			t.Fatalf("Expected %#v to be invalid", m)
+		}
+	}
+}
+
+func TestValidateFieldSelector(t *testing.T) {
+	validCases := []string{
+		"",
+		"status.phase=Running",
+		"spec.nodeName=node-1,status.phase!=Failed",
+	}
+	for _, v := range validCases {
+		_, es := validateFieldSelector(v, "field_selector")
+		if len(es) > 0 {
+			t.Fatalf("Expected %q to be valid: %#v", v, es)
+		}
+	}
+
+	invalidCases := []string{
+		"status.phase",
+		"status.phase~Running",
+	}
+	for _, v := range invalidCases {
+		_, es := validateFieldSelector(v, "field_selector")
+		if len(es) == 0 {
+			t.Fatalf("Expected %q to be invalid", v)
+		}
+	}
+}
+
+func TestValidateJSON(t *testing.T) {
+	validCases := []string{
+		"",
+		"{}",
+		`{"type": "object", "properties": {"replicas": {"type": "integer"}}}`,
+	}
+	for _, v := range validCases {
+		_, es := validateJSON(v, "validation")
+		if len(es) > 0 {
+			t.Fatalf("Expected %q to be valid: %#v", v, es)
+		}
+	}
+
+	invalidCases := []string{
+		"{",
+		`{"type": object}`,
+	}
+	for _, v := range invalidCases {
+		_, es := validateJSON(v, "validation")
+		if len(es) == 0 {
+			t.Fatalf("Expected %q to be invalid", v)
+		}
+	}
+}